@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/infrastructure/db"
+	"job-hunting-service-management-backend/app/internal/repository"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+const usage = `Usage: go run ./app/cmd/import [-dry-run] [-user-id <uuid>] <archive.zip>
+
+エクスポートしたアーカイブからユーザー・ES・各サービスのデータを復元します。
+  -dry-run        差分のみ表示して適用しない
+  -user-id <uuid> 別のアカウントへ移行する場合のインポート先ユーザーID`
+
+// auditCommand 監査イベントに記録するコマンド名
+const auditCommand = "cmd/import"
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "差分のみ表示して適用しない")
+	userIDFlag := flag.String("user-id", "", "インポート先のユーザーID（省略時はエクスポート元のユーザーID）")
+	flag.Usage = func() { fmt.Fprintln(os.Stderr, usage) }
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var targetUserID *uuid.UUID
+	if *userIDFlag != "" {
+		userID, err := uuid.Parse(*userIDFlag)
+		if err != nil {
+			log.Fatalf("Invalid user ID: %s", *userIDFlag)
+		}
+		targetUserID = &userID
+	}
+
+	archive, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal("Failed to read archive:", err)
+	}
+	if len(archive) > usecase.MaxImportArchiveBytes {
+		log.Fatal("Archive exceeds maximum size")
+	}
+
+	database, err := db.NewDB()
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	defer func() {
+		if err := db.Close(database); err != nil {
+			log.Printf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	importUsecase := usecase.NewImportUsecase(
+		repository.NewExportRepository(database),
+		repository.NewImportRepository(database),
+	)
+	auditUsecase := usecase.NewAuditUsecase(repository.NewAuditRepository(database))
+
+	// CLIからの実行のためgin.Contextはnil（リポジトリはDB接続のみ使用）
+	result, err := importUsecase.ImportUser(nil, archive, targetUserID, *dryRun)
+	if err != nil {
+		log.Fatal("Import failed:", err)
+	}
+
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		log.Fatal("Failed to format result:", err)
+	}
+	fmt.Println(string(output))
+
+	if result.Applied {
+		// CLIからのインポートは実行者をsystemとして監査イベントに記録
+		if err := auditUsecase.RecordSystemChange(context.Background(), auditCommand, result.UserID, result.AuditChanges()); err != nil {
+			log.Printf("Failed to record audit event: %v", err)
+		}
+		log.Println("Import completed successfully!")
+	} else {
+		log.Println("Dry run: no changes were applied.")
+	}
+}
@@ -1,17 +1,63 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
+	"strconv"
 
 	"job-hunting-service-management-backend/app/infrastructure/migrate"
 )
 
+const usage = `Usage: go run ./app/cmd/migrate <command> [args]
+
+Commands:
+  up              未適用のマイグレーションを全て適用（デフォルト）
+  down [steps]    適用済みのマイグレーションを新しい順にロールバック（デフォルト: 1件）
+  status          各マイグレーションの適用状況を表示
+  create <name>   次のバージョン番号で空のup/downファイルを作成`
+
 func main() {
-	log.Println("Running database migration...")
+	command := "up"
+	if len(os.Args) > 1 {
+		command = os.Args[1]
+	}
 
-	if err := migrate.Run(); err != nil {
+	var err error
+	switch command {
+	case "up":
+		log.Println("Running database migration...")
+		err = migrate.Run()
+	case "down":
+		steps := 1
+		if len(os.Args) > 2 {
+			steps, err = strconv.Atoi(os.Args[2])
+			if err != nil || steps <= 0 {
+				log.Fatalf("Invalid steps: %s", os.Args[2])
+			}
+		}
+		log.Printf("Rolling back %d migration(s)...", steps)
+		err = migrate.RunDown(steps)
+	case "status":
+		err = migrate.RunStatus()
+	case "create":
+		if len(os.Args) < 3 {
+			log.Fatal("Migration name is required\n\n" + usage)
+		}
+		var paths []string
+		paths, err = migrate.Create(migrate.SourceDir, os.Args[2])
+		for _, path := range paths {
+			fmt.Println("Created", path)
+		}
+	default:
+		log.Fatalf("Unknown command: %s\n\n%s", command, usage)
+	}
+
+	if err != nil {
 		log.Fatal("Migration failed:", err)
 	}
 
-	log.Println("Migration completed successfully!")
+	if command != "status" && command != "create" {
+		log.Println("Migration completed successfully!")
+	}
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"job-hunting-service-management-backend/app/infrastructure/db"
+	"job-hunting-service-management-backend/app/infrastructure/seed"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+const usage = `Usage: go run ./app/cmd/seed [-count <n>]
+
+デモ用の学生（ユーザー・ES・各サービスのプロフィール・更新ログ）を投入します。
+同じ番号の学生は毎回同じユーザーIDと内容になるため、何度実行しても重複しません。
+  -count <n>  投入する学生数（デフォルト: 10、最大: 1000）`
+
+func main() {
+	count := flag.Int("count", seed.DefaultCount, "投入する学生数")
+	flag.Usage = func() { fmt.Fprintln(os.Stderr, usage) }
+	flag.Parse()
+
+	if flag.NArg() != 0 || *count < 1 || *count > seed.MaxCount {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	database, err := db.NewDB()
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+	defer func() {
+		if err := db.Close(database); err != nil {
+			log.Printf("Failed to close database connection: %v", err)
+		}
+	}()
+
+	result, err := seed.Run(context.Background(), repository.NewRepositories(database), *count)
+	if err != nil {
+		log.Fatal("Seed failed:", err)
+	}
+
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		log.Fatal("Failed to format result:", err)
+	}
+	fmt.Println(string(output))
+	log.Printf("Seeded %d demo students successfully!", len(result.Students))
+}
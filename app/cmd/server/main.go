@@ -1,20 +1,31 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"runtime"
+	"runtime/debug"
+	"strconv"
 	"time"
 
+	"job-hunting-service-management-backend/app/infrastructure/auth"
 	"job-hunting-service-management-backend/app/infrastructure/client"
 	"job-hunting-service-management-backend/app/infrastructure/db"
+	"job-hunting-service-management-backend/app/infrastructure/seed"
+	"job-hunting-service-management-backend/app/internal/entity"
 	"job-hunting-service-management-backend/app/internal/handler"
 	"job-hunting-service-management-backend/app/internal/repository"
+	"job-hunting-service-management-backend/app/internal/repository/memory"
 	"job-hunting-service-management-backend/app/internal/router"
 	"job-hunting-service-management-backend/app/internal/usecase"
 
 	"github.com/joho/godotenv"
 )
 
+// version ビルド時に埋め込むバージョン（例: go build -ldflags "-X main.version=v1.2.0"）
+var version = "dev"
+
 func main() {
 	// 日本時間をデフォルトに設定
 	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
@@ -24,80 +35,99 @@ func main() {
 		log.Println("No .env file found or failed to load .env file:", err)
 	}
 
-	// DB接続
-	database, err := db.NewDB()
-	if err != nil {
-		log.Fatal("Failed to connect to database:", err)
-	}
-	defer func() {
-		if err := db.Close(database); err != nil {
-			log.Printf("Failed to close database connection: %v", err)
-		}
-	}()
-
-	log.Println("Database connection successful!")
+	// データストアの選択（DATA_STORE=memoryの場合はDBに接続しない）
+	repos, closeStore := newRepositories()
+	defer closeStore()
 
-	sampleUserRepository := repository.NewSampleUserRepository(database)
-	sampleUserUsecase := usecase.NewSampleUserUsecase(sampleUserRepository)
+	sampleUserUsecase := usecase.NewSampleUserUsecase(repos.SampleUsers)
 	sampleUserHandler := handler.NewSampleUserHandler(sampleUserUsecase)
 
-	logRepository := repository.NewLogRepository(database)
-	logUsecase := usecase.NewLogUsecase(logRepository)
+	// 保存とログ・変更履歴を1つのトランザクションで書き込む（手動の保存・AI生成で共通）
+	unitOfWork := repos.UnitOfWork
+
+	logUsecase := usecase.NewLogUsecase(repos.Logs)
 	logHandler := handler.NewLogHandler(logUsecase)
 
+	// 項目ごとの変更履歴
+	fieldRevisionUsecase := usecase.NewFieldRevisionUsecase(repos.FieldRevisions)
+	fieldRevisionHandler := handler.NewFieldRevisionHandler(fieldRevisionUsecase)
+
 	// AI生成機能（userUsecaseより先に初期化）
 	geminiClient := client.NewGeminiClient()
-	aiGenerationRepository := repository.NewAIGenerationRepository(database)
-	aiGenerationUsecase := usecase.NewAIGenerationUsecase(aiGenerationRepository, geminiClient)
+	aiGenerationUsecase := usecase.NewAIGenerationUsecase(repos.AIGeneration, unitOfWork, geminiClient)
 	aiGenerationHandler := handler.NewAIGenerationHandler(aiGenerationUsecase)
 
-	// UserUsecaseにAI生成機能を依存として渡す
-	userRepository := repository.NewUserRepository(database)
-	// userUsecase := usecase.NewUserUsecase(userRepository, aiGenerationUsecase) // 後で更新されるためコメントアウト
+	// 就活サービス（レジストリに登録された全サービス）
+	serviceUsecases := make(map[string]usecase.ServiceUsecase, len(entity.Services))
+	for _, def := range entity.Services {
+		serviceUsecases[def.Key] = usecase.NewServiceUsecase(def, repos.Services[def.Key], unitOfWork)
+	}
+	serviceHandler := handler.NewServiceHandler(serviceUsecases)
 
-	supporterzRepository := repository.NewSupporterzRepository(database)
-	supporterzUsecase := usecase.NewSupporterzUsecase(supporterzRepository, logUsecase)
-	supporterzHandler := handler.NewSupporterzHandler(supporterzUsecase)
+	// OfferBoxの「私を表す写真」
+	offerBoxPhotoUsecase := usecase.NewOfferBoxPhotoUsecase(repos.OfferBoxPhotos, unitOfWork)
+	offerBoxPhotoHandler := handler.NewOfferBoxPhotoHandler(offerBoxPhotoUsecase)
 
-	careerSelectRepository := repository.NewCareerSelectRepository(database)
-	careerSelectUsecase := usecase.NewCareerSelectUsecase(careerSelectRepository, logUsecase)
-	careerSelectHandler := handler.NewCareerSelectHandler(careerSelectUsecase)
+	// ユーザー定義のカスタムサービス
+	customServiceUsecase := usecase.NewCustomServiceUsecase(repos.CustomServices, unitOfWork)
+	customServiceHandler := handler.NewCustomServiceHandler(customServiceUsecase)
 
-	levtechRookieRepository := repository.NewLevtechRookieRepository(database)
-	levtechRookieUsecase := usecase.NewLevtechRookieUsecase(levtechRookieRepository, logUsecase)
-	levtechRookieHandler := handler.NewLevtechRookieHandler(levtechRookieUsecase)
+	// ES API関連のDI ---
+	profileUsecase := usecase.NewProfileUsecase(repos.Profiles, unitOfWork)
+	profileHandler := handler.NewProfileHandler(profileUsecase)
 
-	mynaviRepository := repository.NewMynaviRepository(database)
-	mynaviUsecase := usecase.NewMynaviUsecase(mynaviRepository, logUsecase)
-	mynaviHandler := handler.NewMynaviHandler(mynaviUsecase)
+	// データエクスポート
+	exportUsecase := usecase.NewExportUsecase(repos.Export)
+	exportHandler := handler.NewExportHandler(exportUsecase)
 
-	oneCareerRepository := repository.NewOneCareerRepository(database)
-	oneCareerUsecase := usecase.NewOneCareerUsecase(oneCareerRepository, logUsecase)
-	oneCareerHandler := handler.NewOneCareerHandler(oneCareerUsecase)
+	// データインポート（エクスポートしたアーカイブからの復元・移行）
+	importUsecase := usecase.NewImportUsecase(repos.Export, repos.Import)
+	importHandler := handler.NewImportHandler(importUsecase)
 
-	// ES API関連のDI ---
-	profileRepository := repository.NewProfileRepository(database)
-	profileUsecase := usecase.NewProfileUsecase(profileRepository, logUsecase)
-	profileHandler := handler.NewProfileHandler(profileUsecase)
+	// ESと全サービスのプロフィールを横断した検索
+	searchUsecase := usecase.NewSearchUsecase(repos.Search)
+	searchHandler := handler.NewSearchHandler(searchUsecase)
+
+	// 稼働状況の確認
+	healthUsecase := usecase.NewHealthUsecase(repos.Health, geminiClient, buildInfo())
+	healthHandler := handler.NewHealthHandler(healthUsecase)
 
-	// UserUsecaseを更新（ProfileUsecaseを追加）
-	userUsecase := usecase.NewUserUsecase(userRepository, aiGenerationUsecase, profileUsecase)
+	// 監査イベント（変更を伴うリクエストの記録と閲覧）
+	auditUsecase := usecase.NewAuditUsecase(repos.Audit)
+	auditHandler := handler.NewAuditHandler(auditUsecase)
+
+	// ユーザー（AI生成・ES・カスタムサービスのユースケースに依存するため、それらの後に初期化）
+	userUsecase := usecase.NewUserUsecase(repos.Users, aiGenerationUsecase, profileUsecase, customServiceUsecase)
 
 	// UserHandlerを全てのサービスUsecaseと一緒に初期化
-	userHandler := handler.NewUserHandler(userUsecase, supporterzUsecase, careerSelectUsecase, levtechRookieUsecase, mynaviUsecase, oneCareerUsecase, profileUsecase)
+	userHandler := handler.NewUserHandler(userUsecase, serviceUsecases, profileUsecase, customServiceUsecase)
+
+	// ユーザー・ES・複数の就活サービスのまとめての更新
+	batchUpdateUsecase := usecase.NewBatchUpdateUsecase(unitOfWork)
+	batchUpdateHandler := handler.NewBatchUpdateHandler(batchUpdateUsecase)
+
+	// アクセストークンの検証（AUTH_JWKS_URLまたはAUTH_JWT_SECRET）
+	verifier := newVerifier()
 
 	// ルーター設定
 	r := router.NewRouter(
 		sampleUserHandler,
 		userHandler,
-		supporterzHandler,
-		careerSelectHandler,
-		levtechRookieHandler,
-		mynaviHandler,
-		oneCareerHandler,
+		serviceHandler,
+		customServiceHandler,
+		offerBoxPhotoHandler,
 		logHandler,
+		fieldRevisionHandler,
 		aiGenerationHandler,
 		profileHandler,
+		exportHandler,
+		importHandler,
+		searchHandler,
+		healthHandler,
+		auditHandler,
+		batchUpdateHandler,
+		auditUsecase,
+		verifier,
 	)
 
 	// ポート番号を環境変数から取得（Renderでは必須）
@@ -111,3 +141,75 @@ func main() {
 		log.Fatal("Failed to start server:", err)
 	}
 }
+
+// newRepositories 環境変数DATA_STOREに応じたリポジトリ一式と、終了時に呼ぶ関数を返す
+// postgres（既定）: DATABASE_URLのDBに接続 / memory: DBを使わずメモリ上に保存（再起動で消える）
+// memoryの場合、SEED_DEMO_STUDENTSに人数を指定するとデモ用の学生を投入して起動する
+func newRepositories() (*repository.Repositories, func()) {
+	switch store := os.Getenv("DATA_STORE"); store {
+	case "memory":
+		log.Println("Using in-memory data store (data is lost on restart)")
+		repos := memory.NewRepositories()
+		if value := os.Getenv("SEED_DEMO_STUDENTS"); value != "" {
+			count, err := strconv.Atoi(value)
+			if err != nil {
+				log.Fatalf("Invalid SEED_DEMO_STUDENTS: %s", value)
+			}
+			result, err := seed.Run(context.Background(), repos, count)
+			if err != nil {
+				log.Fatal("Failed to seed demo students:", err)
+			}
+			log.Printf("Seeded %d demo students", len(result.Students))
+		}
+		return repos, func() {}
+	case "", "postgres":
+		database, err := db.NewDB()
+		if err != nil {
+			log.Fatal("Failed to connect to database:", err)
+		}
+		log.Println("Database connection successful!")
+
+		return repository.NewRepositories(database), func() {
+			if err := db.Close(database); err != nil {
+				log.Printf("Failed to close database connection: %v", err)
+			}
+		}
+	default:
+		log.Fatalf("Unknown DATA_STORE %q (expected postgres or memory)", store)
+		return nil, nil
+	}
+}
+
+// newVerifier 環境変数の設定からアクセストークンの検証を生成
+// AUTH_DISABLED=trueの場合はnil（認証せず、全ユーザーのデータを操作できる。ローカル開発専用）
+func newVerifier() *auth.Verifier {
+	if disabled, _ := strconv.ParseBool(os.Getenv("AUTH_DISABLED")); disabled {
+		log.Println("WARNING: Authentication is disabled (AUTH_DISABLED=true); do not use this in production")
+		return nil
+	}
+	verifier, err := auth.NewVerifier(context.Background(), auth.ConfigFromEnv())
+	if err != nil {
+		log.Fatal("Failed to configure authentication:", err)
+	}
+	return verifier
+}
+
+// buildInfo 稼働中のバイナリの情報（バージョン未指定の場合はRenderのコミットまたはVCSのリビジョン）
+func buildInfo() entity.BuildInfo {
+	info := entity.BuildInfo{Version: version, GoVersion: runtime.Version()}
+	if info.Version != "dev" {
+		return info
+	}
+	if commit := os.Getenv("RENDER_GIT_COMMIT"); commit != "" {
+		info.Version = commit
+		return info
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range bi.Settings {
+			if setting.Key == "vcs.revision" {
+				info.Version = setting.Value
+			}
+		}
+	}
+	return info
+}
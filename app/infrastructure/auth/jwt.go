@@ -0,0 +1,147 @@
+// Package auth アクセストークン（JWT）の検証
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// 検証の既定値
+const (
+	defaultRoleClaim = "role"
+	defaultAdminRole = entity.RoleAdmin
+	leeway           = 30 * time.Second // 発行元とのクロックのずれの許容
+)
+
+var (
+	// ErrNotConfigured 検証に使う鍵（JWKSのURLまたは共有シークレット）が設定されていない
+	ErrNotConfigured = errors.New("authentication is not configured")
+	// ErrInvalidToken アクセストークンが不正（署名・有効期限・発行元・subなど）
+	ErrInvalidToken = errors.New("invalid access token")
+)
+
+// Config アクセストークンの検証設定
+type Config struct {
+	JWKSURL   string // 公開鍵（JWKS）のURL（RS256 / ES256など。認証プロバイダーの鍵）
+	Secret    string // 共有シークレット（HS256。JWKSURLを指定した場合は使わない）
+	Issuer    string // issの期待値（空の場合は検証しない）
+	Audience  string // audの期待値（空の場合は検証しない）
+	RoleClaim string // ロールのクレーム（app_metadata.roleのように.で入れ子を指定できる）
+	AdminRole string // 管理者とみなすロールの値
+}
+
+// ConfigFromEnv 環境変数から検証設定を読み込む
+func ConfigFromEnv() Config {
+	cfg := Config{
+		JWKSURL:   os.Getenv("AUTH_JWKS_URL"),
+		Secret:    os.Getenv("AUTH_JWT_SECRET"),
+		Issuer:    os.Getenv("AUTH_JWT_ISSUER"),
+		Audience:  os.Getenv("AUTH_JWT_AUDIENCE"),
+		RoleClaim: os.Getenv("AUTH_ROLE_CLAIM"),
+		AdminRole: os.Getenv("AUTH_ADMIN_ROLE"),
+	}
+	if cfg.RoleClaim == "" {
+		cfg.RoleClaim = defaultRoleClaim
+	}
+	if cfg.AdminRole == "" {
+		cfg.AdminRole = defaultAdminRole
+	}
+	return cfg
+}
+
+// Verifier アクセストークンを検証し、認証されたユーザーを返す
+type Verifier struct {
+	keyfunc   jwt.Keyfunc
+	parser    *jwt.Parser
+	roleClaim []string
+	adminRole string
+}
+
+// NewVerifier 検証設定からVerifierを生成
+// JWKSはctxが終了するまでバックグラウンドで定期的に再取得する
+func NewVerifier(ctx context.Context, cfg Config) (*Verifier, error) {
+	var (
+		kf      jwt.Keyfunc
+		methods []string
+	)
+	switch {
+	case cfg.JWKSURL != "":
+		jwks, err := keyfunc.NewDefaultCtx(ctx, []string{cfg.JWKSURL})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWKS from %s: %w", cfg.JWKSURL, err)
+		}
+		kf = jwks.Keyfunc
+		methods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+	case cfg.Secret != "":
+		secret := []byte(cfg.Secret)
+		kf = func(*jwt.Token) (interface{}, error) { return secret, nil }
+		methods = []string{"HS256", "HS384", "HS512"}
+	default:
+		return nil, fmt.Errorf("%w: set AUTH_JWKS_URL or AUTH_JWT_SECRET (or AUTH_DISABLED=true for local development)", ErrNotConfigured)
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &Verifier{
+		keyfunc:   kf,
+		parser:    jwt.NewParser(options...),
+		roleClaim: strings.Split(cfg.RoleClaim, "."),
+		adminRole: cfg.AdminRole,
+	}, nil
+}
+
+// Verify アクセストークンの署名・有効期限などを検証し、subをユーザーIDとして返す
+func (v *Verifier) Verify(tokenString string) (*entity.Principal, error) {
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.keyfunc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	userID, err := uuid.Parse(subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: sub must be a user ID", ErrInvalidToken)
+	}
+
+	principal := &entity.Principal{UserID: userID, Role: entity.RoleUser}
+	if role, _ := lookupClaim(claims, v.roleClaim).(string); role != "" && role == v.adminRole {
+		principal.Role = entity.RoleAdmin
+	}
+	return principal, nil
+}
+
+// lookupClaim 入れ子のクレームを取得（見つからない場合はnil）
+func lookupClaim(claims map[string]interface{}, path []string) interface{} {
+	var value interface{} = claims
+	for _, key := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
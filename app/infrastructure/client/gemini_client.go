@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log"
 	"net/http"
+	neturl "net/url"
 	"os"
 	"regexp"
 	"strings"
@@ -53,11 +56,15 @@ type PartResponse struct {
 	Text string `json:"text"`
 }
 
+// ErrGeminiNotConfigured GEMINI_API_KEYが設定されていないため生成できない
+var ErrGeminiNotConfigured = errors.New("GEMINI_API_KEY is not configured")
+
 // 新しいGeminiクライアントを作成
+// APIキーがなくても起動でき、その場合はConfiguredがfalseになり生成はErrGeminiNotConfiguredを返す（/readyzはllmをerrorにする）
 func NewGeminiClient() *GeminiClient {
 	apiKey := os.Getenv("GEMINI_API_KEY")
 	if apiKey == "" {
-		panic("GEMINI_API_KEY environment variable is required")
+		log.Println("Warning: GEMINI_API_KEY is not set, AI generation is disabled")
 	}
 
 	return &GeminiClient{
@@ -102,19 +109,24 @@ func (g *GeminiClient) sendRequestWithRetry(ctx context.Context, req *http.Reque
 }
 
 // プロンプトテンプレートを処理してユーザー情報を埋め込む
-func (g *GeminiClient) ProcessPromptTemplate(serviceName string, user *entity.User) (string, error) {
-	promptTemplate, exists := entity.ServicePrompts[serviceName]
-	if !exists {
+func (g *GeminiClient) ProcessPromptTemplate(serviceName string, data *entity.PromptData) (string, error) {
+	def, exists := entity.FindService(serviceName)
+	if !exists || def.Prompt == "" {
 		return "", fmt.Errorf("prompt template not found for service: %s", serviceName)
 	}
 
+	return renderPrompt(def.Prompt, data)
+}
+
+// テンプレートにデータを埋め込んでプロンプトを生成
+func renderPrompt(promptTemplate string, data interface{}) (string, error) {
 	tmpl, err := template.New("prompt").Parse(promptTemplate)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
 
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, user); err != nil {
+	if err := tmpl.Execute(&buf, data); err != nil {
 		return "", fmt.Errorf("failed to execute template: %w", err)
 	}
 
@@ -123,6 +135,10 @@ func (g *GeminiClient) ProcessPromptTemplate(serviceName string, user *entity.Us
 
 // Gemini APIにリクエストを送信
 func (g *GeminiClient) GenerateContent(ctx context.Context, prompt string) (string, error) {
+	if !g.Configured() {
+		return "", ErrGeminiNotConfigured
+	}
+
 	request := GeminiRequest{
 		Contents: []Content{
 			{
@@ -151,7 +167,7 @@ func (g *GeminiClient) GenerateContent(ctx context.Context, prompt string) (stri
 	// リトライ機能付きでリクエスト送信（最大2回リトライ）
 	resp, err := g.sendRequestWithRetry(ctx, req, 2)
 	if err != nil {
-		return "", fmt.Errorf("failed to send request: %w", err)
+		return "", fmt.Errorf("failed to send request: %w", withoutURL(err))
 	}
 	defer resp.Body.Close()
 
@@ -177,6 +193,43 @@ func (g *GeminiClient) GenerateContent(ctx context.Context, prompt string) (stri
 	return geminiResp.Candidates[0].Content.Parts[0].Text, nil
 }
 
+// withoutURL URLにAPIキーが含まれるため、通信エラーからURLを除いた原因のみを取り出す
+func withoutURL(err error) error {
+	var urlErr *neturl.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+// Configured APIキーが設定されているかどうか
+func (g *GeminiClient) Configured() bool {
+	return g.APIKey != ""
+}
+
+// Ping 生成に使うモデルの情報を取得し、APIに到達できてAPIキーが有効かを確認（生成は行わない）
+func (g *GeminiClient) Ping(ctx context.Context) error {
+	if !g.Configured() {
+		return ErrGeminiNotConfigured
+	}
+	url := fmt.Sprintf("%s?key=%s", strings.TrimSuffix(g.BaseURL, ":generateContent"), g.APIKey)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := g.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach Gemini API: %w", withoutURL(err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("model info request failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
 // JSONコンテンツを抽出する関数
 func extractJSONFromMarkdown(content string) (string, error) {
 	// マークダウンコードブロック（```json と ``` で囲まれた部分）を探す（改行対応）
@@ -200,27 +253,46 @@ func extractJSONFromMarkdown(content string) (string, error) {
 }
 
 // サービス用のコンテンツを生成
-func (g *GeminiClient) GenerateServiceContent(ctx context.Context, serviceName string, user *entity.User) (map[string]interface{}, error) {
-	prompt, err := g.ProcessPromptTemplate(serviceName, user)
+func (g *GeminiClient) GenerateServiceContent(ctx context.Context, serviceName string, data *entity.PromptData) (map[string]interface{}, error) {
+	prompt, err := g.ProcessPromptTemplate(serviceName, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to process prompt template: %w", err)
 	}
 
+	return g.generateJSONContent(ctx, prompt)
+}
+
+// カスタムサービス用のコンテンツを生成（項目定義から組み立てた汎用プロンプトを使用）
+func (g *GeminiClient) GenerateCustomServiceContent(ctx context.Context, service *entity.CustomService, user *entity.User) (map[string]interface{}, error) {
+	prompt, err := renderPrompt(service.BuildPrompt(), entity.CustomServicePromptData{
+		ServiceName: service.Name,
+		User:        user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to process prompt template: %w", err)
+	}
+
+	return g.generateJSONContent(ctx, prompt)
+}
+
+// プロンプトを送信し、レスポンスをJSONとしてパース
+// 送信・レスポンスの解析の失敗はentity.ErrUpstreamとして返す
+func (g *GeminiClient) generateJSONContent(ctx context.Context, prompt string) (map[string]interface{}, error) {
 	content, err := g.GenerateContent(ctx, prompt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate content: %w", err)
+		return nil, fmt.Errorf("%w: failed to generate content: %w", entity.ErrUpstream, err)
 	}
 
 	// マークダウン形式からJSONを抽出
 	jsonContent, err := extractJSONFromMarkdown(content)
 	if err != nil {
-		return nil, fmt.Errorf("failed to extract JSON from response: %w", err)
+		return nil, fmt.Errorf("%w: failed to extract JSON from response: %w", entity.ErrUpstream, err)
 	}
 
 	// JSONレスポンスをパース
 	var result map[string]interface{}
 	if err := json.Unmarshal([]byte(jsonContent), &result); err != nil {
-		return nil, fmt.Errorf("failed to parse generated JSON content: %w\nRaw content: %s\nExtracted JSON: %s", err, content, jsonContent)
+		return nil, fmt.Errorf("%w: failed to parse generated JSON content: %w\nRaw content: %s\nExtracted JSON: %s", entity.ErrUpstream, err, content, jsonContent)
 	}
 
 	return result, nil
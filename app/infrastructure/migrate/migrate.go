@@ -1,8 +1,17 @@
 package migrate
 
 import (
+	"embed"
 	"fmt"
+	"io/fs"
 	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"job-hunting-service-management-backend/app/infrastructure/db"
 	"job-hunting-service-management-backend/app/internal/entity"
@@ -10,7 +19,79 @@ import (
 	"gorm.io/gorm"
 )
 
+// マイグレーションファイルはバイナリに埋め込む
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// SourceDir createサブコマンドでマイグレーションファイルを作成するディレクトリ
+const SourceDir = "app/infrastructure/migrate/migrations"
+
+// ファイル名の形式: {バージョン}_{名前}.{up|down}.sql
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// マイグレーション名に使えない文字
+var migrationNameInvalidChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Migration バージョン付きのマイグレーション
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// SchemaMigration 適用済みマイグレーションの記録
+type SchemaMigration struct {
+	Version   int64     `gorm:"primarykey;autoIncrement:false"` // マイグレーションのバージョン
+	Name      string    `gorm:"not null;size:255"`              // マイグレーション名
+	AppliedAt time.Time `gorm:"type:timestamptz;not null"`      // 適用日時
+}
+
+func (SchemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// MigrationStatus マイグレーションの適用状況
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time // 未適用の場合はnil
+}
+
+// Run 未適用のマイグレーションを全て適用（make migrateから実行）
 func Run() error {
+	return withDB(func(database *gorm.DB) error {
+		return Up(database)
+	})
+}
+
+// RunDown 適用済みのマイグレーションを新しい順にsteps件ロールバック
+func RunDown(steps int) error {
+	return withDB(func(database *gorm.DB) error {
+		return Down(database, steps)
+	})
+}
+
+// RunStatus 各マイグレーションの適用状況を表示
+func RunStatus() error {
+	return withDB(func(database *gorm.DB) error {
+		statuses, err := Status(database)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d  %-40s  %s\n", s.Version, s.Name, applied)
+		}
+		return nil
+	})
+}
+
+func withDB(fn func(database *gorm.DB) error) error {
 	// DB接続
 	database, err := db.NewDB()
 	if err != nil {
@@ -22,57 +103,290 @@ func Run() error {
 		}
 	}()
 
-	log.Println("Starting database migration...")
+	return fn(database)
+}
 
-	entities := []interface{}{
-		&entity.SampleUser{},
-		&entity.User{},
-		&entity.Profile{}, // ここに新しいエンティティを追加
-		&entity.Log{},
-		&entity.Supporterz{},
-		&entity.CareerSelect{},
-		&entity.OneCareer{},
-		&entity.LevtechRookie{},
-		&entity.Mynavi{},
+// Up 未適用のマイグレーションをバージョン順に適用
+func Up(database *gorm.DB) error {
+	migrations, applied, err := load(database)
+	if err != nil {
+		return err
 	}
 
-	// 各エンティティのマイグレーション状況をチェック
-	var migrateTargets []interface{}
-	for _, ent := range entities {
-		if !database.Migrator().HasTable(ent) {
-			migrateTargets = append(migrateTargets, ent)
-			log.Printf("📝 New table detected for migration: %T", ent)
+	count := 0
+	for _, m := range migrations {
+		if _, ok := applied[m.Version]; ok {
+			continue
 		}
+
+		log.Printf("🚀 Applying migration %04d_%s...", m.Version, m.Name)
+		// マイグレーション本体と適用記録を同一トランザクションで実行
+		err := database.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(m.Up).Error; err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{Version: m.Version, Name: m.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+		count++
 	}
 
-	if len(migrateTargets) == 0 {
-		log.Println("📋 No migration targets found. All tables are up to date.")
-		return nil
+	if count == 0 {
+		log.Println("📋 No pending migrations. Database schema is up to date.")
+	} else {
+		log.Printf("Applied %d migration(s) successfully!", count)
 	}
 
-	// マイグレーション実行
-	log.Printf("🚀 Migrating %d entities...", len(migrateTargets))
-	err = database.AutoMigrate(migrateTargets...)
-	if err != nil {
-		return fmt.Errorf("failed to migrate database: %w", err)
+	if err := verifyMigration(database); err != nil {
+		log.Printf("Migration verification failed: %v", err)
+		return err
 	}
+	log.Println("Migration verification passed!")
 
-	log.Println("Database migration completed successfully!")
+	return nil
+}
 
-	if err := verifyMigration(database, entities); err != nil {
-		log.Printf("Migration verification failed: %v", err)
+// Down 適用済みのマイグレーションを新しい順にsteps件ロールバック
+func Down(database *gorm.DB, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive: %d", steps)
+	}
+
+	migrations, applied, err := load(database)
+	if err != nil {
 		return err
-	} else {
-		log.Println("Migration verification passed!")
 	}
 
+	count := 0
+	for i := len(migrations) - 1; i >= 0 && count < steps; i-- {
+		m := migrations[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+
+		log.Printf("⏪ Rolling back migration %04d_%s...", m.Version, m.Name)
+		err := database.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(m.Down).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, "version = ?", m.Version).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to roll back migration %04d_%s: %w", m.Version, m.Name, err)
+		}
+		count++
+	}
+
+	log.Printf("Rolled back %d migration(s)", count)
 	return nil
 }
 
-func verifyMigration(database *gorm.DB, entities []interface{}) error {
-	for _, ent := range entities {
+// Status 全マイグレーションの適用状況をバージョン順に返す
+func Status(database *gorm.DB) ([]MigrationStatus, error) {
+	migrations, applied, err := load(database)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(migrations))
+	for _, m := range migrations {
+		status := MigrationStatus{Version: m.Version, Name: m.Name}
+		if record, ok := applied[m.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending 未適用のマイグレーションをバージョン順に返す
+// 稼働中の確認（/readyz）から呼ぶため、schema_migrationsがなくても作成せず全件を未適用とする
+func Pending(database *gorm.DB) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+	if !database.Migrator().HasTable(&SchemaMigration{}) {
+		return migrations, nil
+	}
+
+	var versions []int64
+	if err := database.Model(&SchemaMigration{}).Pluck("version", &versions).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	applied := make(map[int64]bool, len(versions))
+	for _, v := range versions {
+		applied[v] = true
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		if !applied[m.Version] {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Create 次のバージョン番号で空のup/downファイルを作成し、作成したファイルパスを返す
+func Create(dir, name string) ([]string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = migrationNameInvalidChars.ReplaceAllString(name, "_")
+	name = strings.Trim(name, "_")
+	if name == "" {
+		return nil, fmt.Errorf("migration name is required")
+	}
+
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+	version := int64(1)
+	if len(migrations) > 0 {
+		version = migrations[len(migrations)-1].Version + 1
+	}
+
+	// 埋め込み済みでないファイルもバージョン番号の採番対象にする
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration directory: %w", err)
+	}
+	for _, e := range entries {
+		if matches := migrationFilePattern.FindStringSubmatch(e.Name()); matches != nil {
+			if v, _ := strconv.ParseInt(matches[1], 10, 64); v >= version {
+				version = v + 1
+			}
+		}
+	}
+
+	var paths []string
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%04d_%s.%s.sql", version, name, direction))
+		content := fmt.Sprintf("-- %04d_%s (%s)\n", version, name, direction)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return nil, fmt.Errorf("failed to create migration file: %w", err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// Migrations 埋め込まれたマイグレーションをバージョン順に返す
+func Migrations() ([]Migration, error) {
+	files, err := fs.ReadDir(migrationFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, f := range files {
+		matches := migrationFilePattern.FindStringSubmatch(f.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", f.Name())
+		}
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version: %s", f.Name())
+		}
+
+		content, err := migrationFS.ReadFile("migrations/" + f.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", f.Name(), err)
+		}
+
+		m, exists := byVersion[version]
+		if !exists {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		} else if m.Name != matches[2] {
+			return nil, fmt.Errorf("duplicate migration version %04d: %s and %s", version, m.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s must have both up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// マイグレーション一覧と適用済みの記録を取得（schema_migrationsがなければ作成）
+func load(database *gorm.DB) ([]Migration, map[int64]SchemaMigration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !database.Migrator().HasTable(&SchemaMigration{}) {
+		if err := database.Migrator().CreateTable(&SchemaMigration{}); err != nil {
+			return nil, nil, fmt.Errorf("failed to create schema_migrations table: %w", err)
+		}
+	}
+
+	var records []SchemaMigration
+	if err := database.Order("version").Find(&records).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	applied := make(map[int64]SchemaMigration, len(records))
+	for _, r := range records {
+		applied[r.Version] = r
+	}
+	return migrations, applied, nil
+}
+
+// Entities スキーマの検証対象となるエンティティ
+func Entities() []interface{} {
+	entities := []interface{}{
+		&entity.SampleUser{},
+		&entity.User{},
+		&entity.Profile{}, // ここに新しいエンティティを追加
+		&entity.Log{},
+		&entity.CustomService{},
+		&entity.OfferBoxPhoto{},
+		&entity.AIGenerationHistory{},
+		&entity.FieldRevision{},
+		&entity.AuditEvent{},
+	}
+	// 就活サービスのエンティティはレジストリから追加
+	for _, def := range entity.Services {
+		entities = append(entities, def.NewModel())
+	}
+	return entities
+}
+
+// エンティティに対応するテーブル・カラムがマイグレーションで作成されているか検証
+func verifyMigration(database *gorm.DB) error {
+	for _, ent := range Entities() {
 		if !database.Migrator().HasTable(ent) {
-			return fmt.Errorf("table does not exist for entity: %T", ent)
+			return fmt.Errorf("table does not exist for entity: %T (add a migration file)", ent)
+		}
+
+		stmt := &gorm.Statement{DB: database}
+		if err := stmt.Parse(ent); err != nil {
+			return fmt.Errorf("failed to parse entity %T: %w", ent, err)
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			if !database.Migrator().HasColumn(ent, field.DBName) {
+				return fmt.Errorf("column %s.%s does not exist for entity: %T (add a migration file)", stmt.Schema.Table, field.DBName, ent)
+			}
 		}
 		log.Printf("✅ Table verified: %T", ent)
 	}
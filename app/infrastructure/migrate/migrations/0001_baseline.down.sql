@@ -0,0 +1,12 @@
+DROP TABLE IF EXISTS "offerbox";
+DROP TABLE IF EXISTS "mynavi";
+DROP TABLE IF EXISTS "levtech_rookie";
+DROP TABLE IF EXISTS "one_career";
+DROP TABLE IF EXISTS "career_select";
+DROP TABLE IF EXISTS "supporterz";
+DROP TABLE IF EXISTS "offerbox_photos";
+DROP TABLE IF EXISTS "custom_services";
+DROP TABLE IF EXISTS "logs";
+DROP TABLE IF EXISTS "profiles";
+DROP TABLE IF EXISTS "users";
+DROP TABLE IF EXISTS "sample_users";
@@ -0,0 +1,190 @@
+-- ベースライン: AutoMigrateで作成されていた既存スキーマ
+-- 既存環境ではテーブルが作成済みのため、IF NOT EXISTSで差分のみ作成する
+
+CREATE TABLE IF NOT EXISTS "sample_users" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "name" varchar(100) NOT NULL,
+    "email" varchar(255) NOT NULL,
+    "age" bigint,
+    "is_active" boolean DEFAULT true,
+    "bio" varchar(500),
+    "website" varchar(255),
+    PRIMARY KEY ("id"),
+    CONSTRAINT "chk_sample_users_age" CHECK (age >= 0 AND age <= 150)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_sample_users_email" ON "sample_users" ("email");
+CREATE INDEX IF NOT EXISTS "idx_sample_users_deleted_at" ON "sample_users" ("deleted_at");
+
+CREATE TABLE IF NOT EXISTS "users" (
+    "user_id" uuid,
+    "last_name" varchar(50) NOT NULL,
+    "first_name" varchar(50) NOT NULL,
+    "birth_date" date,
+    "age" bigint,
+    "university" varchar(50),
+    "category" varchar(100),
+    "faculty" varchar(50),
+    "grade" bigint,
+    "target_job_type" varchar(50) NOT NULL,
+    "services" text[],
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("user_id"),
+    CONSTRAINT "chk_users_age" CHECK (age >= 0 AND age <= 150),
+    CONSTRAINT "chk_users_grade" CHECK (grade >= 1 AND grade <= 10)
+);
+
+CREATE TABLE IF NOT EXISTS "profiles" (
+    "id" uuid,
+    "career_vision" varchar(2000),
+    "self_promotion" varchar(5000),
+    "student_experience" varchar(5000),
+    "research" varchar(2000),
+    "products" text[],
+    "product_descriptions" text[],
+    "skills" text[],
+    "skill_descriptions" text[],
+    "interns" text[],
+    "intern_descriptions" text[],
+    "organization" varchar(2000),
+    "certifications" text[],
+    "certification_descriptions" text[],
+    "desired_job_type" varchar(2000),
+    "company_selection_criteria" varchar(2000),
+    "engineer_aspiration" varchar(2000),
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "logs" (
+    "id" uuid,
+    "user_id" uuid,
+    "target_table" varchar(100),
+    "field_name" varchar(100),
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "custom_services" (
+    "id" uuid,
+    "user_id" uuid,
+    "name" varchar(100) NOT NULL,
+    "fields" jsonb,
+    "values" jsonb,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_custom_services_user_id" ON "custom_services" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "offerbox_photos" (
+    "user_id" uuid,
+    "slot" bigint,
+    "content_type" varchar(50) NOT NULL,
+    "data" bytea NOT NULL,
+    "size" bigint,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("user_id", "slot"),
+    CONSTRAINT "chk_offerbox_photos_slot" CHECK (slot >= 1)
+);
+
+CREATE TABLE IF NOT EXISTS "supporterz" (
+    "id" uuid,
+    "career_vision" varchar(200),
+    "self_promotion" varchar(5000),
+    "skills" text[],
+    "skill_descriptions" text[],
+    "intern_experiences" text[],
+    "intern_experience_descriptions" text[],
+    "products" text[],
+    "product_tech_stacks" text[],
+    "product_descriptions" text[],
+    "researches" text[],
+    "research_descriptions" text[],
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "career_select" (
+    "id" uuid,
+    "skills" text[],
+    "skill_descriptions" text[],
+    "company_selection_criteria" text[],
+    "company_selection_criteria_descriptions" text[],
+    "career_vision" varchar(2000),
+    "self_promotion" varchar(5000),
+    "research" varchar(500),
+    "products" text[],
+    "product_descriptions" text[],
+    "experiences" text[],
+    "experience_descriptions" text[],
+    "intern_experiences" text[],
+    "intern_experience_descriptions" text[],
+    "certifications" text[],
+    "certification_descriptions" text[],
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "one_career" (
+    "id" uuid,
+    "skills" text[],
+    "skill_descriptions" text[],
+    "researches" text[],
+    "research_descriptions" text[],
+    "intern_experiences" text[],
+    "intern_experience_descriptions" text[],
+    "products" text[],
+    "product_descriptions" text[],
+    "engineer_aspiration" varchar(1000),
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "levtech_rookie" (
+    "id" uuid,
+    "desired_job_type" text[],
+    "career_aspiration" text[],
+    "interested_tasks" text[],
+    "job_requirements" text[],
+    "interested_industries" text[],
+    "preferred_company_size" text[],
+    "interested_business_types" text[],
+    "preferred_work_location" text[],
+    "skills" text[],
+    "skill_descriptions" text[],
+    "portfolio" varchar(200),
+    "portfolio_description" varchar(2000),
+    "intern_experiences" text[],
+    "intern_experience_descriptions" text[],
+    "hackathon_experiences" text[],
+    "hackathon_experience_descriptions" text[],
+    "research" varchar(2000),
+    "organization" varchar(2000),
+    "other" varchar(2000),
+    "certifications" text[],
+    "languages" text[],
+    "language_levels" text[],
+    PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "mynavi" (
+    "id" uuid,
+    "self_promotion" varchar(1000),
+    "future_plan" varchar(300),
+    PRIMARY KEY ("id")
+);
+-- AutoMigrateは既存テーブルのカラム定義を変更しないため、サイズ変更をここで反映する
+ALTER TABLE "mynavi" ALTER COLUMN "future_plan" TYPE varchar(300);
+
+CREATE TABLE IF NOT EXISTS "offerbox" (
+    "id" uuid,
+    "self_promotion" varchar(1000),
+    "student_experience" varchar(1000),
+    "research" varchar(1000),
+    "future_vision" varchar(1000),
+    "skills" text[],
+    "skill_descriptions" text[],
+    "certifications" text[],
+    "photo_captions" text[],
+    PRIMARY KEY ("id")
+);
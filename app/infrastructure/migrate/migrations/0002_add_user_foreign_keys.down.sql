@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS "idx_logs_user_id";
+
+ALTER TABLE "offerbox" DROP CONSTRAINT IF EXISTS "fk_offerbox_user";
+ALTER TABLE "mynavi" DROP CONSTRAINT IF EXISTS "fk_mynavi_user";
+ALTER TABLE "levtech_rookie" DROP CONSTRAINT IF EXISTS "fk_levtech_rookie_user";
+ALTER TABLE "one_career" DROP CONSTRAINT IF EXISTS "fk_one_career_user";
+ALTER TABLE "career_select" DROP CONSTRAINT IF EXISTS "fk_career_select_user";
+ALTER TABLE "supporterz" DROP CONSTRAINT IF EXISTS "fk_supporterz_user";
+ALTER TABLE "offerbox_photos" DROP CONSTRAINT IF EXISTS "fk_offerbox_photos_user";
+ALTER TABLE "custom_services" DROP CONSTRAINT IF EXISTS "fk_custom_services_user";
+ALTER TABLE "logs" DROP CONSTRAINT IF EXISTS "fk_logs_user";
+ALTER TABLE "profiles" DROP CONSTRAINT IF EXISTS "fk_profiles_user";
@@ -0,0 +1,27 @@
+-- usersを親とする外部キー（ユーザー削除時に関連データもまとめて削除）
+-- 制約の追加前に、存在しないユーザーを参照しているレコードを削除する
+
+DELETE FROM "profiles" WHERE "id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "logs" WHERE "user_id" IS NULL OR "user_id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "custom_services" WHERE "user_id" IS NULL OR "user_id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "offerbox_photos" WHERE "user_id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "supporterz" WHERE "id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "career_select" WHERE "id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "one_career" WHERE "id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "levtech_rookie" WHERE "id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "mynavi" WHERE "id" NOT IN (SELECT "user_id" FROM "users");
+DELETE FROM "offerbox" WHERE "id" NOT IN (SELECT "user_id" FROM "users");
+
+ALTER TABLE "profiles" ADD CONSTRAINT "fk_profiles_user" FOREIGN KEY ("id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "logs" ADD CONSTRAINT "fk_logs_user" FOREIGN KEY ("user_id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "custom_services" ADD CONSTRAINT "fk_custom_services_user" FOREIGN KEY ("user_id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "offerbox_photos" ADD CONSTRAINT "fk_offerbox_photos_user" FOREIGN KEY ("user_id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "supporterz" ADD CONSTRAINT "fk_supporterz_user" FOREIGN KEY ("id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "career_select" ADD CONSTRAINT "fk_career_select_user" FOREIGN KEY ("id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "one_career" ADD CONSTRAINT "fk_one_career_user" FOREIGN KEY ("id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "levtech_rookie" ADD CONSTRAINT "fk_levtech_rookie_user" FOREIGN KEY ("id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "mynavi" ADD CONSTRAINT "fk_mynavi_user" FOREIGN KEY ("id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+ALTER TABLE "offerbox" ADD CONSTRAINT "fk_offerbox_user" FOREIGN KEY ("id") REFERENCES "users" ("user_id") ON DELETE CASCADE;
+
+-- ユーザー単位の検索・カスケード削除用のインデックス
+CREATE INDEX IF NOT EXISTS "idx_logs_user_id" ON "logs" ("user_id");
@@ -0,0 +1,12 @@
+CREATE TABLE "ai_generation_histories" (
+    "id" uuid,
+    "user_id" uuid,
+    "target_table" varchar(100) NOT NULL,
+    "status" varchar(20) NOT NULL,
+    "generated_data" jsonb,
+    "error" text,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_ai_generation_histories_user" FOREIGN KEY ("user_id") REFERENCES "users" ("user_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_ai_generation_histories_user_id" ON "ai_generation_histories" ("user_id");
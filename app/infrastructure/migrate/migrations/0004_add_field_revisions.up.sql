@@ -0,0 +1,13 @@
+CREATE TABLE "field_revisions" (
+    "id" uuid,
+    "user_id" uuid NOT NULL,
+    "target_table" varchar(100) NOT NULL,
+    "field_name" varchar(100) NOT NULL,
+    "old_value" jsonb,
+    "new_value" jsonb,
+    "source" varchar(20) NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_field_revisions_user" FOREIGN KEY ("user_id") REFERENCES "users" ("user_id") ON DELETE CASCADE
+);
+CREATE INDEX "idx_field_revisions_user_field" ON "field_revisions" ("user_id", "target_table", "field_name", "created_at");
@@ -0,0 +1,8 @@
+ALTER TABLE "offerbox" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "mynavi" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "levtech_rookie" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "one_career" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "career_select" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "supporterz" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "profiles" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "users" DROP COLUMN IF EXISTS "version";
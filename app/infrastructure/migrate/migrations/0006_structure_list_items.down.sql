@@ -0,0 +1,99 @@
+-- 一覧項目を対になる配列項目に戻す
+
+ALTER TABLE "supporterz"
+    ADD COLUMN IF NOT EXISTS "skills" text[],
+    ADD COLUMN IF NOT EXISTS "skill_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experiences" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experience_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "products" text[],
+    ADD COLUMN IF NOT EXISTS "product_tech_stacks" text[],
+    ADD COLUMN IF NOT EXISTS "product_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "researches" text[],
+    ADD COLUMN IF NOT EXISTS "research_descriptions" text[];
+UPDATE "supporterz" SET
+    "skills" = ARRAY(SELECT item->>'skills' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "skill_descriptions" = ARRAY(SELECT item->>'skill_descriptions' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experiences" = ARRAY(SELECT item->>'intern_experiences' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experience_descriptions" = ARRAY(SELECT item->>'intern_experience_descriptions' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "products" = ARRAY(SELECT item->>'products' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "product_tech_stacks" = ARRAY(SELECT item->>'product_tech_stacks' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "product_descriptions" = ARRAY(SELECT item->>'product_descriptions' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "researches" = ARRAY(SELECT item->>'researches' FROM jsonb_array_elements("items"->'researches') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "research_descriptions" = ARRAY(SELECT item->>'research_descriptions' FROM jsonb_array_elements("items"->'researches') WITH ORDINALITY AS t(item, i) ORDER BY i);
+ALTER TABLE "supporterz" DROP COLUMN IF EXISTS "items";
+
+ALTER TABLE "career_select"
+    ADD COLUMN IF NOT EXISTS "skills" text[],
+    ADD COLUMN IF NOT EXISTS "skill_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "company_selection_criteria" text[],
+    ADD COLUMN IF NOT EXISTS "company_selection_criteria_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "products" text[],
+    ADD COLUMN IF NOT EXISTS "product_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "experiences" text[],
+    ADD COLUMN IF NOT EXISTS "experience_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experiences" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experience_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "certifications" text[],
+    ADD COLUMN IF NOT EXISTS "certification_descriptions" text[];
+UPDATE "career_select" SET
+    "skills" = ARRAY(SELECT item->>'skills' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "skill_descriptions" = ARRAY(SELECT item->>'skill_descriptions' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "company_selection_criteria" = ARRAY(SELECT item->>'company_selection_criteria' FROM jsonb_array_elements("items"->'company_selection_criteria') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "company_selection_criteria_descriptions" = ARRAY(SELECT item->>'company_selection_criteria_descriptions' FROM jsonb_array_elements("items"->'company_selection_criteria') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "products" = ARRAY(SELECT item->>'products' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "product_descriptions" = ARRAY(SELECT item->>'product_descriptions' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "experiences" = ARRAY(SELECT item->>'experiences' FROM jsonb_array_elements("items"->'experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "experience_descriptions" = ARRAY(SELECT item->>'experience_descriptions' FROM jsonb_array_elements("items"->'experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experiences" = ARRAY(SELECT item->>'intern_experiences' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experience_descriptions" = ARRAY(SELECT item->>'intern_experience_descriptions' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "certifications" = ARRAY(SELECT item->>'certifications' FROM jsonb_array_elements("items"->'certifications') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "certification_descriptions" = ARRAY(SELECT item->>'certification_descriptions' FROM jsonb_array_elements("items"->'certifications') WITH ORDINALITY AS t(item, i) ORDER BY i);
+ALTER TABLE "career_select" DROP COLUMN IF EXISTS "items";
+
+ALTER TABLE "one_career"
+    ADD COLUMN IF NOT EXISTS "skills" text[],
+    ADD COLUMN IF NOT EXISTS "skill_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "researches" text[],
+    ADD COLUMN IF NOT EXISTS "research_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experiences" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experience_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "products" text[],
+    ADD COLUMN IF NOT EXISTS "product_descriptions" text[];
+UPDATE "one_career" SET
+    "skills" = ARRAY(SELECT item->>'skills' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "skill_descriptions" = ARRAY(SELECT item->>'skill_descriptions' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "researches" = ARRAY(SELECT item->>'researches' FROM jsonb_array_elements("items"->'researches') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "research_descriptions" = ARRAY(SELECT item->>'research_descriptions' FROM jsonb_array_elements("items"->'researches') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experiences" = ARRAY(SELECT item->>'intern_experiences' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experience_descriptions" = ARRAY(SELECT item->>'intern_experience_descriptions' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "products" = ARRAY(SELECT item->>'products' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "product_descriptions" = ARRAY(SELECT item->>'product_descriptions' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i);
+ALTER TABLE "one_career" DROP COLUMN IF EXISTS "items";
+
+ALTER TABLE "levtech_rookie"
+    ADD COLUMN IF NOT EXISTS "skills" text[],
+    ADD COLUMN IF NOT EXISTS "skill_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experiences" text[],
+    ADD COLUMN IF NOT EXISTS "intern_experience_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "hackathon_experiences" text[],
+    ADD COLUMN IF NOT EXISTS "hackathon_experience_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "languages" text[],
+    ADD COLUMN IF NOT EXISTS "language_levels" text[];
+UPDATE "levtech_rookie" SET
+    "skills" = ARRAY(SELECT item->>'skills' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "skill_descriptions" = ARRAY(SELECT item->>'skill_descriptions' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experiences" = ARRAY(SELECT item->>'intern_experiences' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_experience_descriptions" = ARRAY(SELECT item->>'intern_experience_descriptions' FROM jsonb_array_elements("items"->'intern_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "hackathon_experiences" = ARRAY(SELECT item->>'hackathon_experiences' FROM jsonb_array_elements("items"->'hackathon_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "hackathon_experience_descriptions" = ARRAY(SELECT item->>'hackathon_experience_descriptions' FROM jsonb_array_elements("items"->'hackathon_experiences') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "languages" = ARRAY(SELECT item->>'languages' FROM jsonb_array_elements("items"->'languages') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "language_levels" = ARRAY(SELECT item->>'language_levels' FROM jsonb_array_elements("items"->'languages') WITH ORDINALITY AS t(item, i) ORDER BY i);
+ALTER TABLE "levtech_rookie" DROP COLUMN IF EXISTS "items";
+
+ALTER TABLE "offerbox"
+    ADD COLUMN IF NOT EXISTS "skills" text[],
+    ADD COLUMN IF NOT EXISTS "skill_descriptions" text[];
+UPDATE "offerbox" SET
+    "skills" = ARRAY(SELECT item->>'skills' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "skill_descriptions" = ARRAY(SELECT item->>'skill_descriptions' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i);
+ALTER TABLE "offerbox" DROP COLUMN IF EXISTS "items";
@@ -0,0 +1,85 @@
+-- 対になる配列項目（スキルと説明など）を、項目IDを持つ一覧項目のJSONBにまとめる
+-- 配列の長さが揃っていない場合も値を失わないよう、最も長い配列に合わせて項目を作成する
+
+ALTER TABLE "supporterz" ADD COLUMN IF NOT EXISTS "items" jsonb NOT NULL DEFAULT '{}';
+UPDATE "supporterz" SET "items" = jsonb_build_object(
+    'skills', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'skills', COALESCE("skills"[i], ''), 'skill_descriptions', COALESCE("skill_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("skills"), cardinality("skill_descriptions"))) AS i),
+    'intern_experiences', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'intern_experiences', COALESCE("intern_experiences"[i], ''), 'intern_experience_descriptions', COALESCE("intern_experience_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("intern_experiences"), cardinality("intern_experience_descriptions"))) AS i),
+    'products', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'products', COALESCE("products"[i], ''), 'product_tech_stacks', COALESCE("product_tech_stacks"[i], ''), 'product_descriptions', COALESCE("product_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("products"), cardinality("product_tech_stacks"), cardinality("product_descriptions"))) AS i),
+    'researches', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'researches', COALESCE("researches"[i], ''), 'research_descriptions', COALESCE("research_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("researches"), cardinality("research_descriptions"))) AS i)
+);
+ALTER TABLE "supporterz"
+    DROP COLUMN "skills",
+    DROP COLUMN "skill_descriptions",
+    DROP COLUMN "intern_experiences",
+    DROP COLUMN "intern_experience_descriptions",
+    DROP COLUMN "products",
+    DROP COLUMN "product_tech_stacks",
+    DROP COLUMN "product_descriptions",
+    DROP COLUMN "researches",
+    DROP COLUMN "research_descriptions";
+
+ALTER TABLE "career_select" ADD COLUMN IF NOT EXISTS "items" jsonb NOT NULL DEFAULT '{}';
+UPDATE "career_select" SET "items" = jsonb_build_object(
+    'skills', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'skills', COALESCE("skills"[i], ''), 'skill_descriptions', COALESCE("skill_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("skills"), cardinality("skill_descriptions"))) AS i),
+    'company_selection_criteria', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'company_selection_criteria', COALESCE("company_selection_criteria"[i], ''), 'company_selection_criteria_descriptions', COALESCE("company_selection_criteria_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("company_selection_criteria"), cardinality("company_selection_criteria_descriptions"))) AS i),
+    'products', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'products', COALESCE("products"[i], ''), 'product_descriptions', COALESCE("product_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("products"), cardinality("product_descriptions"))) AS i),
+    'experiences', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'experiences', COALESCE("experiences"[i], ''), 'experience_descriptions', COALESCE("experience_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("experiences"), cardinality("experience_descriptions"))) AS i),
+    'intern_experiences', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'intern_experiences', COALESCE("intern_experiences"[i], ''), 'intern_experience_descriptions', COALESCE("intern_experience_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("intern_experiences"), cardinality("intern_experience_descriptions"))) AS i),
+    'certifications', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'certifications', COALESCE("certifications"[i], ''), 'certification_descriptions', COALESCE("certification_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("certifications"), cardinality("certification_descriptions"))) AS i)
+);
+ALTER TABLE "career_select"
+    DROP COLUMN "skills",
+    DROP COLUMN "skill_descriptions",
+    DROP COLUMN "company_selection_criteria",
+    DROP COLUMN "company_selection_criteria_descriptions",
+    DROP COLUMN "products",
+    DROP COLUMN "product_descriptions",
+    DROP COLUMN "experiences",
+    DROP COLUMN "experience_descriptions",
+    DROP COLUMN "intern_experiences",
+    DROP COLUMN "intern_experience_descriptions",
+    DROP COLUMN "certifications",
+    DROP COLUMN "certification_descriptions";
+
+ALTER TABLE "one_career" ADD COLUMN IF NOT EXISTS "items" jsonb NOT NULL DEFAULT '{}';
+UPDATE "one_career" SET "items" = jsonb_build_object(
+    'skills', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'skills', COALESCE("skills"[i], ''), 'skill_descriptions', COALESCE("skill_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("skills"), cardinality("skill_descriptions"))) AS i),
+    'researches', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'researches', COALESCE("researches"[i], ''), 'research_descriptions', COALESCE("research_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("researches"), cardinality("research_descriptions"))) AS i),
+    'intern_experiences', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'intern_experiences', COALESCE("intern_experiences"[i], ''), 'intern_experience_descriptions', COALESCE("intern_experience_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("intern_experiences"), cardinality("intern_experience_descriptions"))) AS i),
+    'products', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'products', COALESCE("products"[i], ''), 'product_descriptions', COALESCE("product_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("products"), cardinality("product_descriptions"))) AS i)
+);
+ALTER TABLE "one_career"
+    DROP COLUMN "skills",
+    DROP COLUMN "skill_descriptions",
+    DROP COLUMN "researches",
+    DROP COLUMN "research_descriptions",
+    DROP COLUMN "intern_experiences",
+    DROP COLUMN "intern_experience_descriptions",
+    DROP COLUMN "products",
+    DROP COLUMN "product_descriptions";
+
+ALTER TABLE "levtech_rookie" ADD COLUMN IF NOT EXISTS "items" jsonb NOT NULL DEFAULT '{}';
+UPDATE "levtech_rookie" SET "items" = jsonb_build_object(
+    'skills', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'skills', COALESCE("skills"[i], ''), 'skill_descriptions', COALESCE("skill_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("skills"), cardinality("skill_descriptions"))) AS i),
+    'intern_experiences', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'intern_experiences', COALESCE("intern_experiences"[i], ''), 'intern_experience_descriptions', COALESCE("intern_experience_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("intern_experiences"), cardinality("intern_experience_descriptions"))) AS i),
+    'hackathon_experiences', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'hackathon_experiences', COALESCE("hackathon_experiences"[i], ''), 'hackathon_experience_descriptions', COALESCE("hackathon_experience_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("hackathon_experiences"), cardinality("hackathon_experience_descriptions"))) AS i),
+    'languages', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'languages', COALESCE("languages"[i], ''), 'language_levels', COALESCE("language_levels"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("languages"), cardinality("language_levels"))) AS i)
+);
+ALTER TABLE "levtech_rookie"
+    DROP COLUMN "skills",
+    DROP COLUMN "skill_descriptions",
+    DROP COLUMN "intern_experiences",
+    DROP COLUMN "intern_experience_descriptions",
+    DROP COLUMN "hackathon_experiences",
+    DROP COLUMN "hackathon_experience_descriptions",
+    DROP COLUMN "languages",
+    DROP COLUMN "language_levels";
+
+ALTER TABLE "offerbox" ADD COLUMN IF NOT EXISTS "items" jsonb NOT NULL DEFAULT '{}';
+UPDATE "offerbox" SET "items" = jsonb_build_object(
+    'skills', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'skills', COALESCE("skills"[i], ''), 'skill_descriptions', COALESCE("skill_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("skills"), cardinality("skill_descriptions"))) AS i)
+);
+ALTER TABLE "offerbox"
+    DROP COLUMN "skills",
+    DROP COLUMN "skill_descriptions";
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "idx_logs_user_table_field";
@@ -0,0 +1,14 @@
+-- logsは(user_id, target_table, field_name)ごとに1行とし、ON CONFLICTで更新日時を上書きする
+-- 同時更新で重複した行は、最新のupdated_atの行のみ残す
+
+DELETE FROM "logs" AS l
+USING (
+    SELECT "id", ROW_NUMBER() OVER (
+        PARTITION BY "user_id", "target_table", "field_name"
+        ORDER BY "updated_at" DESC NULLS LAST, "id"
+    ) AS rn
+    FROM "logs"
+) AS ranked
+WHERE l."id" = ranked."id" AND ranked.rn > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_logs_user_table_field" ON "logs" ("user_id", "target_table", "field_name");
@@ -0,0 +1,4 @@
+-- 検索（GET /api/user/:userID/search）で使うトライグラム類似度（word_similarity・<%演算子）を有効にする
+-- 検索対象は1ユーザー分の行に限られるため、インデックスは作成しない
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
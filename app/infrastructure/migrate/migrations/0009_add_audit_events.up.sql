@@ -0,0 +1,20 @@
+-- 監査イベント（誰が・どこから・何を変更したか）
+-- アカウント削除の記録を残すため、usersへの外部キーは設定しない
+CREATE TABLE "audit_events" (
+    "id" uuid,
+    "user_id" uuid NOT NULL,
+    "actor_type" varchar(20) NOT NULL,
+    "actor_id" uuid,
+    "method" varchar(10) NOT NULL,
+    "endpoint" varchar(200) NOT NULL,
+    "path" varchar(500),
+    "status_code" integer NOT NULL,
+    "request_id" varchar(100) NOT NULL,
+    "client_ip" varchar(45),
+    "user_agent" varchar(500),
+    "changes" jsonb NOT NULL DEFAULT '[]',
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX "idx_audit_events_user_created" ON "audit_events" ("user_id", "created_at");
+CREATE INDEX "idx_audit_events_request_id" ON "audit_events" ("request_id");
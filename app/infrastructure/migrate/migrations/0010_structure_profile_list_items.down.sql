@@ -0,0 +1,21 @@
+-- ESの一覧項目を対になる配列項目に戻す
+
+ALTER TABLE "profiles"
+    ADD COLUMN IF NOT EXISTS "products" text[],
+    ADD COLUMN IF NOT EXISTS "product_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "skills" text[],
+    ADD COLUMN IF NOT EXISTS "skill_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "interns" text[],
+    ADD COLUMN IF NOT EXISTS "intern_descriptions" text[],
+    ADD COLUMN IF NOT EXISTS "certifications" text[],
+    ADD COLUMN IF NOT EXISTS "certification_descriptions" text[];
+UPDATE "profiles" SET
+    "products" = ARRAY(SELECT item->>'products' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "product_descriptions" = ARRAY(SELECT item->>'product_descriptions' FROM jsonb_array_elements("items"->'products') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "skills" = ARRAY(SELECT item->>'skills' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "skill_descriptions" = ARRAY(SELECT item->>'skill_descriptions' FROM jsonb_array_elements("items"->'skills') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "interns" = ARRAY(SELECT item->>'interns' FROM jsonb_array_elements("items"->'interns') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "intern_descriptions" = ARRAY(SELECT item->>'intern_descriptions' FROM jsonb_array_elements("items"->'interns') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "certifications" = ARRAY(SELECT item->>'certifications' FROM jsonb_array_elements("items"->'certifications') WITH ORDINALITY AS t(item, i) ORDER BY i),
+    "certification_descriptions" = ARRAY(SELECT item->>'certification_descriptions' FROM jsonb_array_elements("items"->'certifications') WITH ORDINALITY AS t(item, i) ORDER BY i);
+ALTER TABLE "profiles" DROP COLUMN IF EXISTS "items";
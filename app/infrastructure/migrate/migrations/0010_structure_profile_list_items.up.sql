@@ -0,0 +1,19 @@
+-- ES（profiles）の対になる配列項目（製作物と説明など）を、就活サービスと同じく項目IDを持つ一覧項目のJSONBにまとめる
+-- 配列の長さが揃っていない場合も値を失わないよう、最も長い配列に合わせて項目を作成する
+
+ALTER TABLE "profiles" ADD COLUMN IF NOT EXISTS "items" jsonb NOT NULL DEFAULT '{}';
+UPDATE "profiles" SET "items" = jsonb_build_object(
+    'products', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'products', COALESCE("products"[i], ''), 'product_descriptions', COALESCE("product_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("products"), cardinality("product_descriptions"))) AS i),
+    'skills', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'skills', COALESCE("skills"[i], ''), 'skill_descriptions', COALESCE("skill_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("skills"), cardinality("skill_descriptions"))) AS i),
+    'interns', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'interns', COALESCE("interns"[i], ''), 'intern_descriptions', COALESCE("intern_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("interns"), cardinality("intern_descriptions"))) AS i),
+    'certifications', (SELECT COALESCE(jsonb_agg(jsonb_build_object('id', gen_random_uuid(), 'certifications', COALESCE("certifications"[i], ''), 'certification_descriptions', COALESCE("certification_descriptions"[i], '')) ORDER BY i), '[]'::jsonb) FROM generate_series(1, GREATEST(cardinality("certifications"), cardinality("certification_descriptions"))) AS i)
+);
+ALTER TABLE "profiles"
+    DROP COLUMN "products",
+    DROP COLUMN "product_descriptions",
+    DROP COLUMN "skills",
+    DROP COLUMN "skill_descriptions",
+    DROP COLUMN "interns",
+    DROP COLUMN "intern_descriptions",
+    DROP COLUMN "certifications",
+    DROP COLUMN "certification_descriptions";
@@ -0,0 +1,3 @@
+-- ユーザー定義サービスのバージョンを削除
+
+ALTER TABLE "custom_services" DROP COLUMN IF EXISTS "version";
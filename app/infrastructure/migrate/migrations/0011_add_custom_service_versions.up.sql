@@ -0,0 +1,3 @@
+-- ユーザー定義サービスに楽観的ロック用のバージョンを追加（AI生成中の編集を上書きしないため）
+
+ALTER TABLE "custom_services" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
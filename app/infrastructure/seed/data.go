@@ -0,0 +1,173 @@
+package seed
+
+// デモ用の学生データの素材（組み合わせて学生ごとのプロフィールを作る）
+
+var lastNames = []string{"佐藤", "鈴木", "高橋", "田中", "伊藤", "渡辺", "山本", "中村", "小林", "加藤", "吉田", "山田", "松本", "井上", "木村"}
+
+var firstNames = []string{"陽翔", "蓮", "湊", "悠真", "大和", "結衣", "陽菜", "葵", "凛", "美咲", "颯太", "彩花", "優斗", "莉子", "拓海"}
+
+// 大学・学部系統・学部
+var universities = []struct {
+	name     string
+	category string
+	faculty  string
+}{
+	{"東京工業大学", "理工系", "情報理工学院"},
+	{"大阪大学", "理工系", "基礎工学部"},
+	{"九州大学", "理工系", "工学部"},
+	{"早稲田大学", "理工系", "基幹理工学部"},
+	{"慶應義塾大学", "文理融合", "環境情報学部"},
+	{"名古屋大学", "理工系", "情報学部"},
+	{"筑波大学", "理工系", "情報学群"},
+	{"立命館大学", "理工系", "情報理工学部"},
+	{"会津大学", "理工系", "コンピュータ理工学部"},
+	{"明治大学", "文系", "商学部"},
+}
+
+var jobTypes = []string{"バックエンドエンジニア", "フロントエンドエンジニア", "インフラエンジニア", "データサイエンティスト", "モバイルエンジニア", "SRE"}
+
+// スキルとその説明
+var skills = []struct {
+	name        string
+	description string
+}{
+	{"Go", "ゼミの共同研究で使うAPIサーバーをGoとGinで実装し、テストとCIも整備しました。"},
+	{"TypeScript", "ReactとNext.jsを使ったWebアプリを個人開発し、型定義を活かして保守しやすい構成にしました。"},
+	{"Python", "研究のデータ分析でpandasとscikit-learnを使い、前処理から可視化まで一通り行っています。"},
+	{"AWS", "ECSとRDSを使ってハッカソンのプロダクトをデプロイし、Terraformで構成を管理しました。"},
+	{"Docker", "開発環境をDocker Composeで統一し、チームメンバーの環境構築を数分で終わるようにしました。"},
+	{"Kotlin", "Android向けの大学生協アプリをJetpack Composeで開発し、学内で500人に使われています。"},
+	{"PostgreSQL", "インデックス設計と実行計画の確認で、アルバイト先の集計クエリを10倍高速化しました。"},
+	{"Rust", "趣味でCLIツールを作りながら、所有権とライフタイムの考え方を学んでいます。"},
+	{"Flutter", "サークルの出欠管理アプリをFlutterで作り、iOSとAndroidの両方に公開しました。"},
+	{"Kubernetes", "インターンでHelmチャートの整備とArgo CDによるデプロイの自動化を担当しました。"},
+}
+
+// 制作物（名前・技術スタック・説明）
+var products = []struct {
+	name        string
+	techStack   string
+	description string
+}{
+	{"就活管理アプリ", "Go / Next.js / PostgreSQL", "複数の就活サービスのプロフィールを一元管理し、AIで各サービス向けの文章を生成するアプリです。"},
+	{"研究室の在室管理システム", "Python / FastAPI / Raspberry Pi", "ICカードで在室状況を記録し、Slackに通知する仕組みを研究室に導入しました。"},
+	{"レシピ共有SNS", "TypeScript / React / Firebase", "冷蔵庫の食材から作れるレシピを検索できるSNSで、ハッカソンで優秀賞を受賞しました。"},
+	{"講義ノート検索サービス", "Rust / Elasticsearch", "先輩から受け継いだ講義ノートを全文検索できるサービスで、学科内で利用されています。"},
+	{"サークル出欠アプリ", "Flutter / Supabase", "練習の出欠と会費の支払い状況をまとめて管理できるモバイルアプリです。"},
+	{"家計簿API", "Go / gRPC / MySQL", "銀行明細のCSVを取り込んで自動で分類するAPIで、分類ルールをユーザーごとに学習します。"},
+}
+
+// インターン・アルバイト経験とその説明
+var interns = []struct {
+	name        string
+	description string
+}{
+	{"Web系ベンチャーでの長期インターン（1年）", "決済機能のバックエンド開発を担当し、障害時のリトライ処理の設計からリリースまで経験しました。"},
+	{"大手SIerでのサマーインターン（2週間）", "チームで業務システムの要件定義から設計までを行い、最終発表で最優秀チームに選ばれました。"},
+	{"データ分析企業でのインターン（6ヶ月）", "広告の効果測定のダッシュボードを作成し、週次レポートの作成時間を半分にしました。"},
+	{"ゲーム会社でのアルバイト（8ヶ月）", "運用ツールの改善を担当し、イベント設定のミスを防ぐバリデーションを追加しました。"},
+	{"スタートアップでの開発アルバイト（1年半）", "モバイルアプリのリニューアルを2人で担当し、クラッシュ率を大きく下げました。"},
+}
+
+// 研究テーマとその説明
+var researches = []struct {
+	title       string
+	description string
+}{
+	{"分散システムにおける障害検知の研究", "マイクロサービス間の通信ログから障害の予兆を検知する手法を研究しています。"},
+	{"日本語の文書要約に関する研究", "大規模言語モデルを使い、長い議事録を要点ごとに要約する手法を評価しています。"},
+	{"推薦システムの公平性に関する研究", "人気のある商品に推薦が偏る問題を緩和するための再ランキング手法を研究しています。"},
+	{"組込み機器の省電力化の研究", "センサーノードのスリープ制御を工夫し、電池の持ちを2倍にする方法を検討しています。"},
+	{"ソフトウェアテストの自動生成の研究", "既存のテストコードから境界値を推定し、不足しているテストケースを生成する手法を研究しています。"},
+}
+
+// 資格とその説明
+var certifications = []struct {
+	name        string
+	description string
+}{
+	{"基本情報技術者試験", "大学2年次に取得し、アルゴリズムとネットワークの基礎を体系的に学びました。"},
+	{"応用情報技術者試験", "設計やマネジメントの知識を身につけるために、大学3年次に取得しました。"},
+	{"AWS認定ソリューションアーキテクト – アソシエイト", "インターンでAWSを使う機会が増えたため、設計の定石を学ぶ目的で取得しました。"},
+	{"TOEIC 800点", "英語の技術文書を読む機会が多いため、継続して学習しています。"},
+}
+
+// 学生時代に力を入れたこと（ガクチカ）
+var studentExperiences = []string{
+	"プログラミングサークルの代表として、新入生向けの勉強会を企画し、年間の参加者を3倍に増やしました。参加者のレベルに合わせて課題を3段階に分けたことで、途中で離脱する人を減らせました。",
+	"学園祭の実行委員として来場者の受付システムを開発しました。紙の受付で発生していた長い待ち時間を、QRコードの導入によって平均10分から1分に短縮しました。",
+	"個人開発したアプリを1年間運用し、ユーザーからの要望をもとに30回以上の改善を重ねました。レビューの評価を3.2から4.5まで上げることができました。",
+	"飲食店のアルバイトで、シフト作成を自動化するツールを作りました。店長の作業時間を週3時間削減し、他の店舗にも展開されました。",
+}
+
+// 部活・サークル・団体活動
+var organizations = []string{
+	"プログラミングサークル（代表）",
+	"軽音楽部（会計）",
+	"学園祭実行委員会（システム担当）",
+	"競技プログラミング部",
+	"国際交流サークル",
+}
+
+// 会社選びの軸とその説明
+var selectionCriteria = []struct {
+	name        string
+	description string
+}{
+	{"技術力を高められる環境", "コードレビューや勉強会の文化があり、若手でも設計から任せてもらえる環境を重視しています。"},
+	{"ユーザーに近いプロダクト開発", "自分の作った機能の反響を直接感じられる、自社サービスの開発に携わりたいと考えています。"},
+	{"社会課題の解決", "医療や教育など、社会的な意義の大きい領域でソフトウェアの力を活かしたいです。"},
+	{"チームで成果を出す文化", "個人の成果だけでなく、チーム全体で改善を続ける文化のある会社を選びたいです。"},
+}
+
+// キャリアビジョン・将来の夢
+var careerVisions = []string{
+	"まずはバックエンドの設計と運用を一通り経験し、5年後には大規模なサービスの技術的な意思決定を担えるエンジニアになりたいです。",
+	"ユーザーの課題を深く理解し、プロダクトの方向性まで提案できるエンジニアを目指しています。将来的にはテックリードとしてチームを率いたいです。",
+	"データとソフトウェアの両方に強いエンジニアとして、データに基づいた意思決定を支える仕組みを作りたいです。",
+	"インフラからアプリケーションまで幅広く理解したうえで、信頼性の高いサービスを支えるSREとして活躍したいです。",
+}
+
+// 自己PR
+var selfPromotions = []string{
+	"私の強みは、課題を見つけて自分から改善に動ける行動力です。インターン先では、手作業で行われていたリリース作業の手順を洗い出し、自動化の提案から実装までを担当しました。その結果、リリースにかかる時間を1時間から10分に短縮できました。",
+	"私の強みは、粘り強く学び続ける姿勢です。独学でプログラミングを始めてから3年間、毎日コードを書くことを続け、個人開発のアプリを5つ公開しました。わからないことは公式ドキュメントやソースコードまで読んで解決するようにしています。",
+	"私の強みは、チームの意見をまとめて前に進める調整力です。ハッカソンでは、意見が割れた際に全員の案を評価軸で比較する場を設け、短時間で方針を決めることができました。最終的にチームは優秀賞を受賞しました。",
+	"私の強みは、相手の立場に立って考える力です。アルバイト先で使われていた管理ツールについて、実際に使う人にヒアリングを重ねて改善し、入力ミスを大幅に減らすことができました。",
+}
+
+// エンジニアとしての志望動機・理想のエンジニア像
+var engineerAspirations = []string{
+	"自分の書いたコードで誰かの不便を解消できたときの喜びが、エンジニアを志望する一番の理由です。技術の変化を楽しみながら、長く使われるソフトウェアを作り続けたいです。",
+	"技術的な深さとユーザー視点の両方を持ったエンジニアが理想です。仕組みを理解したうえで、なぜそれを作るのかを説明できるエンジニアになりたいです。",
+	"チームの生産性を高められるエンジニアを目指しています。開発基盤やドキュメントの整備を通じて、周りのメンバーが力を発揮できる環境を作りたいです。",
+}
+
+// LevTech Rookieの希望条件
+var (
+	careerAspirations       = []string{"スペシャリスト志向", "テックリード志向", "プロダクトマネージャー志向"}
+	interestedTasks         = []string{"Webアプリケーション開発", "API設計", "インフラ構築・運用", "データ基盤の開発", "モバイルアプリ開発"}
+	jobRequirements         = []string{"リモートワーク可", "研修制度の充実", "副業可", "フレックスタイム制"}
+	interestedIndustries    = []string{"SaaS", "FinTech", "EdTech", "HRTech", "ゲーム", "ヘルスケア"}
+	preferredCompanySizes   = []string{"スタートアップ（〜100名）", "メガベンチャー（1000名〜）", "中規模（100〜1000名）"}
+	interestedBusinessTypes = []string{"自社開発", "受託開発", "SaaS提供"}
+	preferredWorkLocations  = []string{"東京都", "大阪府", "福岡県", "フルリモート"}
+	languages               = []struct {
+		name  string
+		level string
+	}{
+		{"日本語", "ネイティブ"},
+		{"英語", "ビジネス会話レベル"},
+		{"中国語", "日常会話レベル"},
+	}
+)
+
+// ハッカソン経験とその説明
+var hackathons = []struct {
+	name        string
+	description string
+}{
+	{"技育ハッカソン（優秀賞）", "3人チームでレシピ共有SNSを2日間で開発し、バックエンドとデプロイを担当しました。"},
+	{"学内ハッカソン（最優秀賞）", "キャンパスの混雑状況を可視化するアプリを作り、実際に学生課に採用されました。"},
+	{"企業主催のオンラインハッカソン", "生成AIを使った議事録要約ツールを開発し、プロンプトの改善を担当しました。"},
+}
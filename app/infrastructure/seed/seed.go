@@ -0,0 +1,319 @@
+// Package seed デモ用の学生（ユーザー・ES・各サービスのプロフィール・更新ログ）の投入
+// 学生のユーザーIDと内容は番号から決まるため、何度実行しても同じ学生が上書きされるだけで重複しない
+package seed
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// auditCommand 監査イベントに記録するコマンド名
+const auditCommand = "cmd/seed"
+
+const (
+	DefaultCount   = 10   // 既定の学生数
+	MaxCount       = 1000 // 一度に投入できる学生数の上限
+	logHistoryDays = 30   // 更新ログを散らばらせる期間（日）
+)
+
+// namespace デモ用の学生のIDを生成する名前空間（変更すると既存のデモデータと別の学生になる）
+var namespace = uuid.MustParse("6f1c1a52-3f8e-4d7b-9a51-2b9e0c7d4e10")
+
+// epoch 作成日時・生年月日の基準日（実行日に依存させないため固定）
+var epoch = time.Date(2025, 4, 1, 9, 0, 0, 0, repository.JST)
+
+// Result 投入結果
+type Result struct {
+	Students []Student `json:"students"` // 投入した学生
+	Logs     int       `json:"logs"`     // 投入した更新ログの件数
+}
+
+// Student 投入した学生の概要
+type Student struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Name     string    `json:"name"`
+	Services []string  `json:"services"` // 利用する就活サービス（日本語名）
+}
+
+// StudentID n番目（1始まり）のデモ用の学生のユーザーID
+func StudentID(n int) uuid.UUID {
+	return uuid.NewSHA1(namespace, []byte(fmt.Sprintf("demo-student-%d", n)))
+}
+
+// Run 1〜count番目のデモ用の学生を投入（既に存在する場合は同じ内容で上書き）
+func Run(ctx context.Context, repos *repository.Repositories, count int) (*Result, error) {
+	if count < 1 || count > MaxCount {
+		return nil, fmt.Errorf("count must be between 1 and %d", MaxCount)
+	}
+
+	now := time.Now().In(repository.JST)
+	result := &Result{Students: make([]Student, 0, count)}
+	for n := 1; n <= count; n++ {
+		data, logs := generateStudent(n, now)
+
+		// インポートと同じ経路でユーザー・ES・各サービスを1トランザクションでupsert
+		if err := repos.Import.ApplyImport(nil, data); err != nil {
+			return nil, fmt.Errorf("failed to seed demo student %d: %w", n, err)
+		}
+		if err := repos.Logs.RestoreLogs(ctx, logs); err != nil {
+			return nil, fmt.Errorf("failed to seed logs of demo student %d: %w", n, err)
+		}
+		if err := repos.Audit.CreateEvent(ctx, entity.NewSystemAuditEvent(auditCommand, data.User.UserID, auditChanges(data))); err != nil {
+			return nil, fmt.Errorf("failed to record audit event of demo student %d: %w", n, err)
+		}
+
+		result.Students = append(result.Students, Student{
+			UserID:   data.User.UserID,
+			Name:     data.User.LastName + " " + data.User.FirstName,
+			Services: data.User.Services,
+		})
+		result.Logs += len(logs)
+	}
+	return result, nil
+}
+
+// auditChanges 投入したテーブルと項目（監査イベント用）
+func auditChanges(data *entity.UserExport) entity.AuditChanges {
+	changes := entity.AuditChanges{
+		{TargetTable: data.User.TableName(), Source: entity.AuditSourceImport},
+		{TargetTable: entity.ProfileTargetTable, Fields: fieldKeys(data.Profile), Source: entity.AuditSourceImport},
+	}
+	for _, def := range entity.Services {
+		if _, exists := data.Services[def.Key]; exists {
+			changes = append(changes, entity.AuditChange{TargetTable: def.Key, Fields: fieldKeys(data.Services[def.Key]), Source: entity.AuditSourceImport})
+		}
+	}
+	return changes
+}
+
+// fieldKeys 入力済みの項目のJSONキー
+func fieldKeys(model interface{}) []string {
+	keys := make([]string, 0)
+	for _, f := range entity.ModelFields(model) {
+		if !f.IsEmpty(model) {
+			keys = append(keys, f.Key)
+		}
+	}
+	return keys
+}
+
+// generateStudent n番目の学生のデータと更新ログを生成
+func generateStudent(n int, now time.Time) (*entity.UserExport, []entity.Log) {
+	// 学生ごとに番号をシードにした乱数を使い、毎回同じ内容を生成する
+	r := rand.New(rand.NewSource(int64(n))) //nolint:gosec // デモデータの生成のため暗号論的な乱数は不要
+	userID := StudentID(n)
+
+	user := generateUser(r, userID, n)
+	values := generateValues(r, user, n)
+
+	profile := &entity.Profile{ID: userID}
+	fill(profile, values)
+
+	services := make(map[string]interface{})
+	for _, i := range r.Perm(len(entity.Services))[:2+r.Intn(3)] {
+		def := entity.Services[i]
+		model := def.NewModel()
+		entity.SetModelID(model, userID)
+		fill(model, values)
+		services[def.Key] = model
+	}
+	// users.servicesはレジストリの表示順で保存する
+	for _, def := range entity.Services {
+		if _, exists := services[def.Key]; exists {
+			user.Services = append(user.Services, def.DisplayName)
+		}
+	}
+
+	logs := generateLogs(r, userID, profile, now)
+	for _, def := range entity.Services {
+		if model, exists := services[def.Key]; exists {
+			logs = append(logs, generateLogs(r, userID, model, now)...)
+		}
+	}
+
+	return &entity.UserExport{User: user, Profile: profile, Services: services}, logs
+}
+
+// generateUser ユーザー情報を生成
+func generateUser(r *rand.Rand, userID uuid.UUID, n int) *entity.User {
+	university := universities[r.Intn(len(universities))]
+	grade := 3 + r.Intn(3) // 学部3年〜修士1年
+	age := 18 + grade + r.Intn(2)
+	birth := epoch.AddDate(-age, 0, -r.Intn(365))
+	birthDate := time.Date(birth.Year(), birth.Month(), birth.Day(), 0, 0, 0, 0, time.UTC)
+
+	return &entity.User{
+		UserID:        userID,
+		LastName:      lastNames[r.Intn(len(lastNames))],
+		FirstName:     firstNames[r.Intn(len(firstNames))],
+		BirthDate:     &birthDate,
+		Age:           age,
+		University:    university.name,
+		Category:      university.category,
+		Faculty:       university.faculty,
+		Grade:         grade,
+		TargetJobType: jobTypes[r.Intn(len(jobTypes))],
+		CreatedAt:     epoch.Add(time.Duration(n) * time.Hour),
+	}
+}
+
+// generateValues ES・各サービスの項目キーごとの値（stringまたは[]string）を生成
+// 同じキーの項目はどのサービスでも同じ内容になる
+func generateValues(r *rand.Rand, user *entity.User, n int) map[string]interface{} {
+	values := map[string]interface{}{
+		"career_vision":       careerVisions[r.Intn(len(careerVisions))],
+		"self_promotion":      selfPromotions[r.Intn(len(selfPromotions))],
+		"student_experience":  studentExperiences[r.Intn(len(studentExperiences))],
+		"engineer_aspiration": engineerAspirations[r.Intn(len(engineerAspirations))],
+		"organization":        organizations[r.Intn(len(organizations))],
+		"portfolio":           fmt.Sprintf("https://github.com/demo-student-%d", n),
+	}
+	values["future_vision"] = values["career_vision"]
+	values["future_plan"] = values["career_vision"]
+	values["experiences"] = []string{values["organization"].(string)}
+	values["experience_descriptions"] = []string{values["student_experience"].(string)}
+
+	var names, descriptions []string
+	for _, i := range pick(r, len(skills), 2, 4) {
+		names, descriptions = append(names, skills[i].name), append(descriptions, skills[i].description)
+	}
+	values["skills"], values["skill_descriptions"] = names, descriptions
+
+	names, descriptions = nil, nil
+	var techStacks []string
+	for _, i := range pick(r, len(products), 1, 2) {
+		names, descriptions = append(names, products[i].name), append(descriptions, products[i].description)
+		techStacks = append(techStacks, products[i].techStack)
+	}
+	values["products"], values["product_descriptions"], values["product_tech_stacks"] = names, descriptions, techStacks
+	values["portfolio_description"] = descriptions[0]
+
+	names, descriptions = nil, nil
+	for _, i := range pick(r, len(interns), 1, 2) {
+		names, descriptions = append(names, interns[i].name), append(descriptions, interns[i].description)
+	}
+	values["interns"], values["intern_descriptions"] = names, descriptions
+	values["intern_experiences"], values["intern_experience_descriptions"] = names, descriptions
+
+	research := researches[r.Intn(len(researches))]
+	values["research"] = research.title + "。" + research.description
+	values["researches"], values["research_descriptions"] = []string{research.title}, []string{research.description}
+
+	names, descriptions = nil, nil
+	for _, i := range pick(r, len(certifications), 1, 2) {
+		names, descriptions = append(names, certifications[i].name), append(descriptions, certifications[i].description)
+	}
+	values["certifications"], values["certification_descriptions"] = names, descriptions
+
+	names, descriptions = nil, nil
+	for _, i := range pick(r, len(selectionCriteria), 1, 3) {
+		names, descriptions = append(names, selectionCriteria[i].name), append(descriptions, selectionCriteria[i].description)
+	}
+	values["company_selection_criteria"], values["company_selection_criteria_descriptions"] = names, descriptions
+
+	names, descriptions = nil, nil
+	for _, i := range pick(r, len(hackathons), 1, 2) {
+		names, descriptions = append(names, hackathons[i].name), append(descriptions, hackathons[i].description)
+	}
+	values["hackathon_experiences"], values["hackathon_experience_descriptions"] = names, descriptions
+
+	// 母語に加えて外国語を1つ
+	foreign := languages[1+r.Intn(len(languages)-1)]
+	values["languages"] = []string{languages[0].name, foreign.name}
+	values["language_levels"] = []string{languages[0].level, foreign.level}
+
+	// LevTech Rookieの希望条件（希望職種は志望職種を先頭にする）
+	desiredJobTypes := []string{user.TargetJobType}
+	for _, i := range pick(r, len(jobTypes), 1, 2) {
+		if jobTypes[i] != user.TargetJobType {
+			desiredJobTypes = append(desiredJobTypes, jobTypes[i])
+		}
+	}
+	values["desired_job_type"] = desiredJobTypes
+	values["career_aspiration"] = pickStrings(r, careerAspirations, 1, 1)
+	values["interested_tasks"] = pickStrings(r, interestedTasks, 2, 3)
+	values["job_requirements"] = pickStrings(r, jobRequirements, 1, 2)
+	values["interested_industries"] = pickStrings(r, interestedIndustries, 2, 3)
+	values["preferred_company_size"] = pickStrings(r, preferredCompanySizes, 1, 2)
+	values["interested_business_types"] = pickStrings(r, interestedBusinessTypes, 1, 2)
+	values["preferred_work_location"] = pickStrings(r, preferredWorkLocations, 1, 2)
+	return values
+}
+
+// generateLogs エンティティの入力済みの項目ごとに、過去logHistoryDays日以内の更新ログを生成
+func generateLogs(r *rand.Rand, userID uuid.UUID, model interface{}, now time.Time) []entity.Log {
+	table := model.(interface{ TableName() string }).TableName()
+	logs := make([]entity.Log, 0)
+	for _, f := range entity.ModelFields(model) {
+		if f.IsEmpty(model) {
+			continue
+		}
+		elapsed := time.Duration(r.Int63n(int64(logHistoryDays * 24 * time.Hour)))
+		logs = append(logs, entity.Log{
+			ID:          uuid.NewSHA1(userID, []byte(table+"/"+f.Key)),
+			UserID:      userID,
+			TargetTable: table,
+			FieldName:   f.Key,
+			UpdatedAt:   now.Add(-elapsed),
+		})
+	}
+	return logs
+}
+
+// fill エンティティの各項目に値を設定（文字列項目に配列の値を設定する場合は「、」で連結する）
+func fill(model interface{}, values map[string]interface{}) {
+	for _, f := range entity.ModelFields(model) {
+		switch v := values[f.Key].(type) {
+		case string:
+			if f.List {
+				f.SetList(model, []string{v})
+			} else {
+				f.SetString(model, truncate(v, f.MaxLength))
+			}
+		case []string:
+			if f.List {
+				f.SetList(model, v)
+			} else {
+				f.SetString(model, truncate(strings.Join(v, "、"), f.MaxLength))
+			}
+		}
+	}
+}
+
+// truncate 最大文字数を超える場合に切り詰める
+func truncate(s string, maxLength *int) string {
+	if maxLength == nil {
+		return s
+	}
+	runes := []rune(s)
+	if len(runes) <= *maxLength {
+		return s
+	}
+	return string(runes[:*maxLength])
+}
+
+// pick 0〜size-1から重複なくmin〜max個を選ぶ
+func pick(r *rand.Rand, size, min, max int) []int {
+	k := min + r.Intn(max-min+1)
+	if k > size {
+		k = size
+	}
+	return r.Perm(size)[:k]
+}
+
+// pickStrings 候補から重複なくmin〜max個を選ぶ
+func pickStrings(r *rand.Rand, candidates []string, min, max int) []string {
+	picked := make([]string, 0, max)
+	for _, i := range pick(r, len(candidates), min, max) {
+		picked = append(picked, candidates[i])
+	}
+	return picked
+}
@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"database/sql/driver"
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -10,6 +13,11 @@ type AIGenerationRequest struct {
 	Services []string  `json:"services" binding:"required"`
 }
 
+// GenerateServiceProfilesRequest AI生成リクエスト（/api/v1、ユーザーIDはパスで指定）
+type GenerateServiceProfilesRequest struct {
+	Services []string `json:"services" binding:"required"` // サービスキーまたは日本語サービス名
+}
+
 // AI生成レスポンスの構造体
 type AIGenerationResponse struct {
 	UserID  uuid.UUID              `json:"user_id"`
@@ -18,45 +26,56 @@ type AIGenerationResponse struct {
 	Message string                 `json:"message,omitempty"`
 }
 
-// プロンプトテンプレートの構造体
-type PromptTemplate struct {
-	ServiceName string `json:"service_name"`
-	Template    string `json:"template"`
+// AI生成履歴のステータス
+const (
+	AIGenerationStatusSuccess = "success"
+	AIGenerationStatusError   = "error"
+)
+
+// AIGenerationHistory AI生成の実行履歴（サービスごとに1件）
+type AIGenerationHistory struct {
+	ID            uuid.UUID `gorm:"type:uuid;primarykey" json:"id"`        // 履歴ID（主キー）
+	UserID        uuid.UUID `gorm:"type:uuid;index" json:"user_id"`        // ユーザーID
+	TargetTable   string    `gorm:"not null;size:100" json:"target_table"` // 生成対象（サービスキー、またはカスタムサービスのcustom:{id}）
+	Status        string    `gorm:"not null;size:20" json:"status"`        // 生成結果（success / error）
+	GeneratedData JSONMap   `gorm:"type:jsonb" json:"generated_data"`      // 生成された内容
+	Error         string    `gorm:"type:text" json:"error,omitempty"`      // エラー内容
+	CreatedAt     time.Time `gorm:"type:timestamptz" json:"created_at"`    // 生成日時
 }
 
-// 日本語サービス名からアルファベットへの変換マップ
-var ServiceNameMap = map[string]string{
-	"サポーターズ":    "supporterz",
-	"キャリアセレクト":  "career_select",
-	"ワンキャリア":    "one_career",
-	"レバテックルーキー": "levtech_rookie",
-	"マイナビ":      "mynavi",
+func (AIGenerationHistory) TableName() string {
+	return "ai_generation_histories"
 }
 
-// アルファベットから日本語サービス名への逆変換マップ
-var ReverseServiceNameMap = map[string]string{
-	"supporterz":     "サポーターズ",
-	"career_select":  "キャリアセレクト",
-	"one_career":     "ワンキャリア",
-	"levtech_rookie": "レバテックルーキー",
-	"mynavi":         "マイナビ",
+// JSONMap 任意のJSONオブジェクト（JSONBとして保存）
+type JSONMap map[string]interface{}
+
+// Value JSONBとして保存
+func (m JSONMap) Value() (driver.Value, error) {
+	return marshalJSONB(m)
 }
 
-// サービス名変換関数
-func ConvertServiceName(japaneseName string) (string, bool) {
-	englishName, exists := ServiceNameMap[japaneseName]
-	return englishName, exists
+// Scan JSONBから読み込み
+func (m *JSONMap) Scan(src interface{}) error {
+	return unmarshalJSONB(src, m)
 }
 
-// 逆変換関数
-func ConvertServiceNameToJapanese(englishName string) (string, bool) {
-	japaneseName, exists := ReverseServiceNameMap[englishName]
-	return japaneseName, exists
+// PromptData プロンプトテンプレートに埋め込むデータ
+// Userを埋め込んでいるため、テンプレートからは{{.LastName}}のように直接参照できる
+type PromptData struct {
+	*User
+	Profile *Profile // ES（未登録の場合はnil）
 }
 
-// 各サービス用のプロンプトテンプレート
-var ServicePrompts = map[string]string{
-	"supporterz": `
+// プロンプトテンプレートの構造体
+type PromptTemplate struct {
+	ServiceName string `json:"service_name"`
+	Template    string `json:"template"`
+}
+
+// 各サービス用のプロンプトテンプレート（ServiceDefinition.Promptから参照）
+const (
+	supporterzPrompt = `
 あなたはサポーターズの就活支援AIです。以下のユーザー情報に基づいて、サポーターズのプロフィール項目を日本語で生成してください。
 
 ユーザー情報:
@@ -90,9 +109,9 @@ JSONオブジェクトのみを返してください。マークダウンのコ
   "product_descriptions": ["制作物1を作る中であなたが担当した箇所や工夫した点、受賞歴などを500字以内で記述", "制作物2を作る中であなたが担当した箇所や工夫した点、受賞歴などを500字以内で記述", "制作物3を作る中であなたが担当した箇所や工夫した点、受賞歴などを500字以内で記述", "制作物4を作る中であなたが担当した箇所や工夫した点、受賞歴などを200字以内で記述"],
   "researches": ["研究テーマ1", "研究テーマ2"],
   "research_descriptions": ["研究テーマ1の詳細説明を500字以内で記述", "研究テーマ2の詳細説明を500字以内で記述"]
-}`,
+}`
 
-	"career_select": `
+	careerSelectPrompt = `
 あなたはキャリアセレクトの就活支援AIです。以下のユーザー情報に基づいて、キャリアセレクトのプロフィール項目を日本語で生成してください。
 
 ユーザー情報:
@@ -124,9 +143,9 @@ JSONオブジェクトのみを返してください。マークダウンのコ
   "intern_experience_descriptions": ["インターン経験1の詳細"],
   "certifications": ["資格1", "資格2"],
   "certification_descriptions": ["資格1の詳細", "資格2の詳細"]
-}`,
+}`
 
-	"one_career": `
+	oneCareerPrompt = `
 あなたはワンキャリアの就活支援AIです。以下のユーザー情報に基づいて、ワンキャリアのプロフィール項目を日本語で生成してください。
 
 ユーザー情報:
@@ -152,9 +171,9 @@ JSONオブジェクトのみを返してください。マークダウンのコ
   "products": ["制作物1", "制作物2"],
   "product_descriptions": ["制作物1の説明", "制作物2の説明"],
   "engineer_aspiration": "エンジニア志望動機を5-7行で記述"
-}`,
+}`
 
-	"mynavi": `
+	mynaviPrompt = `
 あなたはマイナビの就活支援AIです。以下のユーザー情報に基づいて、マイナビのプロフィール項目を日本語で生成してください。
 
 ユーザー情報:
@@ -171,9 +190,9 @@ JSONオブジェクトのみを返してください。マークダウンのコ
 {
   "self_promotion": "自己PRを5-7行で記述",
   "future_plan": "将来のキャリアプランを3-5行で記述"
-}`,
+}`
 
-	"levtech_rookie": `
+	levtechRookiePrompt = `
 あなたはレバテックルーキーの就活支援AIです。以下のユーザー情報に基づいて、レバテックルーキーのプロフィール項目を日本語で生成してください。
 
 ユーザー情報:
@@ -212,5 +231,32 @@ JSONオブジェクトのみを返してください。マークダウンのコ
   "certifications": ["資格1", "資格2"],
   "languages": ["使用言語1", "使用言語2"],
   "language_levels": ["言語1のレベル", "言語2のレベル"]
-}`,
+}`
+)
+
+// NewGeneratedServiceModel AI生成結果（JSONのマップ）からサービスのエンティティを組み立てる
+// 項目定義にない項目や、文字列・文字列の配列以外の値は無視する
+func NewGeneratedServiceModel(def ServiceDefinition, userID uuid.UUID, data map[string]interface{}) interface{} {
+	model := def.NewModel()
+	SetModelID(model, userID) // user_idを直接IDとして使用
+
+	for _, field := range def.Fields() {
+		if !field.List {
+			value, _ := data[field.Key].(string)
+			field.SetString(model, value)
+			continue
+		}
+
+		var values []string
+		if items, ok := data[field.Key].([]interface{}); ok {
+			values = make([]string, 0, len(items))
+			for _, item := range items {
+				if str, ok := item.(string); ok {
+					values = append(values, str)
+				}
+			}
+		}
+		field.SetList(model, values)
+	}
+	return model
 }
@@ -0,0 +1,259 @@
+package entity
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 変更の実行者の種類
+const (
+	ActorTypeUser   = "user"   // 本人
+	ActorTypeAdmin  = "admin"  // 管理者ロールのユーザー（actor_idは管理者のユーザーID）
+	ActorTypeAI     = "ai"     // AI生成（actor_idは生成を実行したユーザー）
+	ActorTypeSystem = "system" // CLI（インポート・シード）などサーバー外からの変更
+)
+
+// 変更の発生元（manual / ai / revertはRevisionSource*と共通）
+const (
+	AuditSourceImport = "import" // アーカイブからのインポート・シード
+	AuditSourceDelete = "delete" // アカウント・データの削除
+)
+
+// AuditActorTypes 監査イベントの実行者の種類の一覧
+var AuditActorTypes = []string{ActorTypeUser, ActorTypeAdmin, ActorTypeAI, ActorTypeSystem}
+
+// 監査イベントの一覧の件数
+const (
+	DefaultAuditEventLimit = 50
+	MaxAuditEventLimit     = 200
+)
+
+// AuditContextKey リクエストの監査情報をgin.Contextに保存するキー
+const AuditContextKey = "audit"
+
+// ErrInvalidAuditFilter 監査イベントの絞り込み条件が不正
+var ErrInvalidAuditFilter = NewKindError(ErrValidation, "invalid audit filter")
+
+// AuditEvent 監査イベント（誰が・どこから・何を変更したか）
+// 変更を伴うリクエスト1件につき1件記録し、アカウント削除以外では削除しない
+// アカウント削除の後に記録するイベントは削除の記録として残すため、IPアドレス・User-Agentを持たない
+type AuditEvent struct {
+	ID         uuid.UUID    `gorm:"type:uuid;primarykey" json:"id"`              // イベントID（主キー）
+	UserID     uuid.UUID    `gorm:"type:uuid;not null" json:"user_id"`           // 変更されたユーザー
+	ActorType  string       `gorm:"not null;size:20" json:"actor_type"`          // 実行者の種類（user / admin / ai / system）
+	ActorID    *uuid.UUID   `gorm:"type:uuid" json:"actor_id"`                   // 実行者のID（不明な場合はnull）
+	Method     string       `gorm:"not null;size:10" json:"method"`              // HTTPメソッド（CLIの場合はコマンド名）
+	Endpoint   string       `gorm:"not null;size:200" json:"endpoint"`           // ルーティングのパス（/api/supporterz/:idなど）
+	Path       string       `gorm:"size:500" json:"path"`                        // 実際のパス
+	StatusCode int          `gorm:"not null" json:"status_code"`                 // レスポンスのステータスコード
+	RequestID  string       `gorm:"not null;size:100" json:"request_id"`         // リクエストID（X-Request-ID）
+	ClientIP   string       `gorm:"size:45" json:"client_ip"`                    // クライアントのIPアドレス
+	UserAgent  string       `gorm:"size:500" json:"user_agent"`                  // User-Agent
+	Changes    AuditChanges `gorm:"type:jsonb;not null" json:"changes"`          // 変更したテーブルと項目
+	CreatedAt  time.Time    `gorm:"type:timestamptz;not null" json:"created_at"` // 記録日時
+}
+
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
+
+// AuditChange 1つのテーブルへの変更
+type AuditChange struct {
+	TargetTable string   `json:"target_table"`     // 対象テーブル（サービスキー・profiles・usersなど）
+	Fields      []string `json:"fields,omitempty"` // 変更した項目のJSONキー
+	Source      string   `json:"source"`           // 変更の発生元（manual / ai / revert / import）
+}
+
+// AuditChanges 監査イベントの変更の一覧（JSONBとして保存）
+type AuditChanges []AuditChange
+
+// Value JSONBとして保存
+func (c AuditChanges) Value() (driver.Value, error) {
+	if c == nil {
+		c = AuditChanges{}
+	}
+	return marshalJSONB(c)
+}
+
+// Scan JSONBから読み込み
+func (c *AuditChanges) Scan(src interface{}) error {
+	return unmarshalJSONB(src, c)
+}
+
+// AuditFilter 監査イベントの絞り込み条件
+type AuditFilter struct {
+	ActorType   string     // 実行者の種類
+	TargetTable string     // 変更したテーブル
+	RequestID   string     // リクエストID
+	From        *time.Time // この日時以降
+	To          *time.Time // この日時より前
+	Limit       int
+	Offset      int
+}
+
+// AuditEventList 監査イベントの一覧（新しい順）
+type AuditEventList struct {
+	Total  int64        `json:"total"`  // 絞り込み条件に一致する件数
+	Events []AuditEvent `json:"events"` // limit・offsetの範囲のイベント
+}
+
+// ValidateAuditFilter 絞り込み条件を検証し、件数を既定値・上限に揃える
+func ValidateAuditFilter(filter *AuditFilter) error {
+	if filter.ActorType != "" && !slices.Contains(AuditActorTypes, filter.ActorType) {
+		return fmt.Errorf("%w: unknown actor_type %s", ErrInvalidAuditFilter, filter.ActorType)
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidAuditFilter)
+	}
+	if filter.Offset < 0 {
+		return fmt.Errorf("%w: offset must not be negative", ErrInvalidAuditFilter)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultAuditEventLimit
+	}
+	if filter.Limit > MaxAuditEventLimit {
+		filter.Limit = MaxAuditEventLimit
+	}
+	return nil
+}
+
+// AuditContext リクエスト中の監査情報（ミドルウェアが生成し、ユースケースが変更を追加する）
+// AI生成はサービスごとに並行して保存するため、変更の追加はロックを取る
+type AuditContext struct {
+	RequestID string
+	Method    string
+	Endpoint  string
+	Path      string
+	ClientIP  string
+	UserAgent string
+
+	mu        sync.Mutex
+	actorType string
+	actorID   *uuid.UUID
+	userID    *uuid.UUID
+	changes   AuditChanges
+}
+
+// auditContextKey リクエストのcontext.Contextに監査情報を保存するキー
+type auditContextKey struct{}
+
+// WithAuditContext 監査情報を持つコンテキストを生成（c.Request.Context()を使うユースケース向け）
+func WithAuditContext(ctx context.Context, audit *AuditContext) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, audit)
+}
+
+// AuditContextFrom コンテキスト（gin.Contextまたはリクエストのコンテキスト）から監査情報を取得
+// CLIなどHTTP以外から呼ばれた場合はnil
+func AuditContextFrom(ctx context.Context) *AuditContext {
+	if ctx == nil {
+		return nil
+	}
+	if audit, ok := ctx.Value(auditContextKey{}).(*AuditContext); ok {
+		return audit
+	}
+	audit, _ := ctx.Value(AuditContextKey).(*AuditContext)
+	return audit
+}
+
+// SetActor 実行者を設定（認証で実行者が分かった場合。未設定の場合は変更されたユーザー本人とみなす）
+func (a *AuditContext) SetActor(actorType string, actorID *uuid.UUID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.actorType, a.actorID = actorType, actorID
+}
+
+// RecordChange 変更を追加（同じテーブル・発生元の変更は項目をまとめる）
+func (a *AuditContext) RecordChange(userID uuid.UUID, change AuditChange) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.userID == nil {
+		a.userID = &userID
+	}
+	for i := range a.changes {
+		existing := &a.changes[i]
+		if existing.TargetTable == change.TargetTable && existing.Source == change.Source {
+			for _, field := range change.Fields {
+				if !slices.Contains(existing.Fields, field) {
+					existing.Fields = append(existing.Fields, field)
+				}
+			}
+			return
+		}
+	}
+	change.Fields = append([]string(nil), change.Fields...)
+	a.changes = append(a.changes, change)
+}
+
+// NewSystemAuditEvent CLIなどHTTP以外からの変更の監査イベントを生成（実行者はsystem、変更がない場合はnil）
+func NewSystemAuditEvent(command string, userID uuid.UUID, changes AuditChanges) *AuditEvent {
+	audit := &AuditContext{RequestID: uuid.NewString(), Method: "CLI", Endpoint: command}
+	audit.SetActor(ActorTypeSystem, nil)
+	for _, change := range changes {
+		audit.RecordChange(userID, change)
+	}
+	return audit.Event(0)
+}
+
+// Event 記録された変更から監査イベントを生成（変更がない場合はnil）
+// 変更はコミット後に追加されるため、一部のサービスのみ保存できたエラーのレスポンスでも生成する
+func (a *AuditContext) Event(statusCode int) *AuditEvent {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.userID == nil {
+		return nil
+	}
+
+	actorType, actorID := a.actorType, a.actorID
+	if actorType == "" {
+		// 実行者が不明な場合は本人の変更とし、AI生成による変更を含む場合はAIとする
+		actorType, actorID = ActorTypeUser, a.userID
+		for _, change := range a.changes {
+			if change.Source == RevisionSourceAI {
+				actorType = ActorTypeAI
+				break
+			}
+		}
+	}
+
+	changes := make(AuditChanges, len(a.changes))
+	copy(changes, a.changes)
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].TargetTable < changes[j].TargetTable })
+
+	event := &AuditEvent{
+		ID:         uuid.New(),
+		UserID:     *a.userID,
+		ActorType:  actorType,
+		ActorID:    actorID,
+		Method:     a.Method,
+		Endpoint:   a.Endpoint,
+		Path:       a.Path,
+		StatusCode: statusCode,
+		RequestID:  a.RequestID,
+		ClientIP:   a.ClientIP,
+		UserAgent:  a.UserAgent,
+		Changes:    changes,
+		CreatedAt:  time.Now(),
+	}
+	// アカウントの削除は個人データを残さない削除の記録とする（IPアドレス・User-Agentを記録しない）
+	if changes.includesAccountDeletion() {
+		event.ClientIP, event.UserAgent = "", ""
+	}
+	return event
+}
+
+// includesAccountDeletion アカウント（usersのレコード）の削除を含むか
+func (c AuditChanges) includesAccountDeletion() bool {
+	for _, change := range c {
+		if change.TargetTable == (User{}).TableName() && change.Source == AuditSourceDelete {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// 認証されたユーザーのロール
+const (
+	RoleUser  = "user"  // 学生本人（自分のデータのみ操作できる）
+	RoleAdmin = "admin" // 管理者（全ユーザーのデータを操作できる）
+)
+
+// PrincipalContextKey 認証されたユーザーをgin.Contextに保存するキー
+const PrincipalContextKey = "principal"
+
+// ErrForbidden 呼び出し元がデータの所有者でも管理者でもない
+var ErrForbidden = errors.New("forbidden")
+
+// Principal アクセストークンで認証されたユーザー
+type Principal struct {
+	UserID uuid.UUID // アクセストークンのsub（usersのuser_id）
+	Role   string    // ロール（user / admin）
+}
+
+// IsAdmin 管理者か
+func (p *Principal) IsAdmin() bool {
+	return p.Role == RoleAdmin
+}
+
+// principalContextKey リクエストのcontext.Contextに認証されたユーザーを保存するキー
+type principalContextKey struct{}
+
+// WithPrincipal 認証されたユーザーを持つコンテキストを生成（c.Request.Context()を使うユースケース向け）
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFrom コンテキスト（gin.Contextまたはリクエストのコンテキスト）から認証されたユーザーを取得
+// 認証を通らない呼び出し（CLI・認証を無効にした場合）はnil
+func PrincipalFrom(ctx context.Context) *Principal {
+	if ctx == nil {
+		return nil
+	}
+	if principal, ok := ctx.Value(principalContextKey{}).(*Principal); ok {
+		return principal
+	}
+	principal, _ := ctx.Value(PrincipalContextKey).(*Principal)
+	return principal
+}
+
+// AuthorizeUser 呼び出し元がユーザーのデータにアクセスできるか（本人または管理者）
+// 認証されたユーザーがいない呼び出しは制限しない（APIのルートは認証ミドルウェアで保護する）
+func AuthorizeUser(ctx context.Context, userID uuid.UUID) error {
+	principal := PrincipalFrom(ctx)
+	if principal == nil || principal.IsAdmin() || principal.UserID == userID {
+		return nil
+	}
+	// 他のユーザーのIDをレスポンスに含めない
+	return ErrForbidden
+}
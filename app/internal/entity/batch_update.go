@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// バッチ更新のパート名（サービスはサービスキー）
+const (
+	BatchPartUser    = "user"
+	BatchPartProfile = "profile"
+)
+
+// バッチ更新のパートごとの結果
+const (
+	BatchPartStatusSaved   = "saved"   // 保存した
+	BatchPartStatusFailed  = "failed"  // このパートのエラー（errorに内容）で全体を保存しなかった
+	BatchPartStatusSkipped = "skipped" // このパートに問題はないが、他のパートのエラーで保存しなかった
+)
+
+// ErrEmptyBatch バッチ更新のリクエストに更新するパートがない
+var ErrEmptyBatch = NewKindError(ErrValidation, "at least one of user, profile and services is required")
+
+// BatchUpdateRequest ユーザー・ES・複数の就活サービスをまとめて更新するリクエスト（ユーザーIDはパスで指定）
+// 全パートを検証してから1つのトランザクションで保存し、1つでも失敗した場合はどのパートも保存しない
+type BatchUpdateRequest struct {
+	User     *BatchUserPart              `json:"user"`     // PUT /api/v1/users/:userIDと同じ（空の項目は変更しない）
+	Profile  *BatchProfilePart           `json:"profile"`  // PUT /api/v1/users/:userID/profileと同じ（空の項目は変更しない）
+	Services map[string]BatchServicePart `json:"services"` // キーはサービスキーまたは日本語サービス名、PUTと同じくプロフィール全体を保存
+}
+
+// BatchUserPart ユーザー情報のパート
+type BatchUserPart struct {
+	Data    UserData `json:"data" binding:"required"`
+	Version *int64   `json:"version"` // If-Matchと同じく、現在のバージョンと一致しない場合は412（省略時は確認しない）
+}
+
+// BatchProfilePart ESのパート
+type BatchProfilePart struct {
+	Data    ProfileData `json:"data" binding:"required"`
+	Version *int64      `json:"version"` // If-Matchと同じく、現在のバージョンと一致しない場合は412（省略時は確認しない）
+}
+
+// BatchServicePart 就活サービスのプロフィールのパート
+type BatchServicePart struct {
+	Data    json.RawMessage `json:"data" binding:"required"`
+	Version *int64          `json:"version"` // If-Matchと同じく、現在のバージョンと一致しない場合は412（省略時は確認しない）
+}
+
+// BatchPartResult パートごとの結果（user・profile・サービスキーの順）
+type BatchPartResult struct {
+	Part   string      `json:"part"`            // user / profile / サービスキー
+	Status string      `json:"status"`          // saved / failed / skipped
+	Data   interface{} `json:"data,omitempty"`  // 保存後のレコード（savedの場合）
+	Error  *ErrorBody  `json:"error,omitempty"` // failedの場合のエラー（エラーのマッピングで設定する）
+	Err    error       `json:"-"`
+}
+
+// BatchUpdateResponse バッチ更新の結果（全パートを保存した場合）
+type BatchUpdateResponse struct {
+	Results []BatchPartResult `json:"results"`
+}
+
+// BatchError いずれかのパートが失敗し、どのパートも保存しなかった
+// 最初に失敗したパートのエラーの種類でステータスコードを決め、エラーレスポンスのresultsにパートごとの結果を含める
+type BatchError struct {
+	Results []BatchPartResult
+}
+
+func (e *BatchError) Error() string {
+	var failures []string
+	for _, result := range e.Results {
+		if result.Err != nil {
+			failures = append(failures, result.Part+": "+result.Err.Error())
+		}
+	}
+	return "batch update failed: " + strings.Join(failures, "; ")
+}
+
+// Unwrap 最初に失敗したパートのエラー
+func (e *BatchError) Unwrap() error {
+	for _, result := range e.Results {
+		if result.Err != nil {
+			return result.Err
+		}
+	}
+	return errors.New("batch update failed")
+}
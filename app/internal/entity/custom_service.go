@@ -0,0 +1,307 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// CustomService ユーザーが独自に定義した就活サービス（paiza、Wantedly、企業のマイページ等）
+type CustomService struct {
+	ID        uuid.UUID           `gorm:"type:uuid;primarykey" json:"id"`     // カスタムサービスID（主キー）
+	UserID    uuid.UUID           `gorm:"type:uuid;index" json:"user_id"`     // ユーザーID
+	Name      string              `gorm:"not null;size:100" json:"name"`      // サービス名
+	Fields    CustomServiceFields `gorm:"type:jsonb" json:"fields"`           // 項目定義
+	Values    CustomServiceValues `gorm:"type:jsonb" json:"values"`           // 入力値（項目キー → 値）
+	CreatedAt time.Time           `json:"created_at"`                         // 作成日時
+	UpdatedAt time.Time           `gorm:"type:timestamptz" json:"updated_at"` // 更新日時
+	Version   int64               `gorm:"not null;default:1" json:"version"`  // 楽観的ロック用のバージョン（更新のたびに1ずつ増える）
+}
+
+func (CustomService) TableName() string {
+	return "custom_services"
+}
+
+// CustomServiceField カスタムサービスの項目定義
+type CustomServiceField struct {
+	Key       string `json:"key"`                  // 項目キー（英小文字・数字・アンダースコア）
+	Label     string `json:"label"`                // 日本語ラベル
+	Type      string `json:"type"`                 // 項目の種類（text / list）
+	MaxLength *int   `json:"max_length,omitempty"` // 最大文字数（listの場合は各要素の最大文字数）
+}
+
+// CustomServiceFields 項目定義の一覧（JSONBとして保存）
+type CustomServiceFields []CustomServiceField
+
+// CustomServiceValues 入力値（JSONBとして保存）
+type CustomServiceValues map[string]interface{}
+
+// CustomServiceData カスタムサービスの定義リクエスト
+type CustomServiceData struct {
+	Name   string               `json:"name" binding:"required"`   // サービス名
+	Fields []CustomServiceField `json:"fields" binding:"required"` // 項目定義
+}
+
+// CreateCustomServiceRequest カスタムサービス作成リクエスト
+type CreateCustomServiceRequest struct {
+	UserID string            `json:"user_id" binding:"required"` // ユーザーID
+	Data   CustomServiceData `json:"data" binding:"required"`    // カスタムサービスの定義
+}
+
+// SaveCustomServiceValuesRequest カスタムサービスの入力値保存リクエスト
+type SaveCustomServiceValuesRequest struct {
+	Data map[string]interface{} `json:"data" binding:"required"` // 項目キー → 値
+}
+
+// MaxCustomServiceFields カスタムサービスに定義できる項目数の上限
+const MaxCustomServiceFields = 50
+
+// customServiceLogPrefix logsテーブルのtarget_tableの接頭辞
+const customServiceLogPrefix = "custom:"
+
+var customServiceFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// ErrInvalidCustomService カスタムサービスの定義または入力値が不正
+var ErrInvalidCustomService = NewKindError(ErrValidation, "invalid custom service")
+
+// LogTable logsテーブルのtarget_tableに使う名前
+func (s *CustomService) LogTable() string {
+	return customServiceLogPrefix + s.ID.String()
+}
+
+// Metadata 組み込みサービスと同じ形式の項目定義を生成（フォーム生成用）
+func (s *CustomService) Metadata() ServiceMetadata {
+	fields := make([]ServiceField, 0, len(s.Fields))
+	for i, f := range s.Fields {
+		fields = append(fields, ServiceField{
+			Key:       f.Key,
+			Label:     f.Label,
+			Type:      f.Type,
+			List:      f.Type == FieldTypeList,
+			MaxLength: f.MaxLength,
+			Order:     i + 1,
+		})
+	}
+	return ServiceMetadata{
+		Key:         s.LogTable(),
+		DisplayName: s.Name,
+		Fields:      fields,
+	}
+}
+
+// ValidateCustomServiceData 項目定義のバリデーション
+func ValidateCustomServiceData(data CustomServiceData) error {
+	if strings.TrimSpace(data.Name) == "" || utf8.RuneCountInString(data.Name) > 100 {
+		return fmt.Errorf("%w: name must be 1-100 characters", ErrInvalidCustomService)
+	}
+	if len(data.Fields) == 0 || len(data.Fields) > MaxCustomServiceFields {
+		return fmt.Errorf("%w: fields must contain 1-%d items", ErrInvalidCustomService, MaxCustomServiceFields)
+	}
+
+	seen := make(map[string]bool, len(data.Fields))
+	for _, f := range data.Fields {
+		if !customServiceFieldKeyPattern.MatchString(f.Key) {
+			return fmt.Errorf("%w: invalid field key %q", ErrInvalidCustomService, f.Key)
+		}
+		if seen[f.Key] {
+			return fmt.Errorf("%w: duplicate field key %q", ErrInvalidCustomService, f.Key)
+		}
+		seen[f.Key] = true
+
+		if strings.TrimSpace(f.Label) == "" {
+			return fmt.Errorf("%w: label is required for field %q", ErrInvalidCustomService, f.Key)
+		}
+		if f.Type != FieldTypeText && f.Type != FieldTypeList {
+			return fmt.Errorf("%w: type of field %q must be %q or %q", ErrInvalidCustomService, f.Key, FieldTypeText, FieldTypeList)
+		}
+		if f.MaxLength != nil && *f.MaxLength <= 0 {
+			return fmt.Errorf("%w: max_length of field %q must be positive", ErrInvalidCustomService, f.Key)
+		}
+	}
+	return nil
+}
+
+// NormalizeValues 入力値を項目定義に従って検証・変換
+// 定義にない項目や型・文字数の不一致はエラーとする
+func (s *CustomService) NormalizeValues(input map[string]interface{}) (CustomServiceValues, error) {
+	fields := make(map[string]CustomServiceField, len(s.Fields))
+	for _, f := range s.Fields {
+		fields[f.Key] = f
+	}
+
+	values := make(CustomServiceValues, len(input))
+	for key, raw := range input {
+		field, exists := fields[key]
+		if !exists {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidCustomService, key)
+		}
+
+		switch field.Type {
+		case FieldTypeText:
+			text, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("%w: field %q must be a string", ErrInvalidCustomService, key)
+			}
+			if err := field.checkLength(text); err != nil {
+				return nil, err
+			}
+			values[key] = text
+		case FieldTypeList:
+			items, ok := raw.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: field %q must be an array of strings", ErrInvalidCustomService, key)
+			}
+			list := make([]string, 0, len(items))
+			for _, item := range items {
+				text, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("%w: field %q must be an array of strings", ErrInvalidCustomService, key)
+				}
+				if err := field.checkLength(text); err != nil {
+					return nil, err
+				}
+				list = append(list, text)
+			}
+			values[key] = list
+		}
+	}
+	return values, nil
+}
+
+// FilterValues 項目定義にあるキーだけを残す（AI生成結果の取り込み用）
+func (s *CustomService) FilterValues(input map[string]interface{}) map[string]interface{} {
+	filtered := make(map[string]interface{}, len(s.Fields))
+	for _, f := range s.Fields {
+		if value, exists := input[f.Key]; exists {
+			filtered[f.Key] = value
+		}
+	}
+	return filtered
+}
+
+// NonEmptyKeys 値が空でない項目のキー一覧（ログ記録用）
+func (v CustomServiceValues) NonEmptyKeys() []string {
+	keys := make([]string, 0, len(v))
+	for key, value := range v {
+		switch val := value.(type) {
+		case string:
+			if val != "" {
+				keys = append(keys, key)
+			}
+		case []string:
+			if len(val) > 0 {
+				keys = append(keys, key)
+			}
+		case []interface{}:
+			if len(val) > 0 {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+func (f CustomServiceField) checkLength(text string) error {
+	if f.MaxLength != nil && utf8.RuneCountInString(text) > *f.MaxLength {
+		return fmt.Errorf("%w: field %q exceeds maximum length of %d characters", ErrInvalidCustomService, f.Key, *f.MaxLength)
+	}
+	return nil
+}
+
+// Value JSONBとして保存
+func (f CustomServiceFields) Value() (driver.Value, error) {
+	return marshalJSONB(f)
+}
+
+// Scan JSONBから読み込み
+func (f *CustomServiceFields) Scan(src interface{}) error {
+	return unmarshalJSONB(src, f)
+}
+
+// Value JSONBとして保存
+func (v CustomServiceValues) Value() (driver.Value, error) {
+	return marshalJSONB(v)
+}
+
+// Scan JSONBから読み込み
+func (v *CustomServiceValues) Scan(src interface{}) error {
+	return unmarshalJSONB(src, v)
+}
+
+func marshalJSONB(v interface{}) (driver.Value, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+func unmarshalJSONB(src interface{}, dest interface{}) error {
+	switch data := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(data, dest)
+	case string:
+		return json.Unmarshal([]byte(data), dest)
+	default:
+		return fmt.Errorf("unsupported JSONB source type: %T", src)
+	}
+}
+
+// customServicePromptHeader カスタムサービス用プロンプトの共通部分（ユーザー情報）
+const customServicePromptHeader = `
+あなたは就活支援AIです。以下のユーザー情報に基づいて、「{{.ServiceName}}」のプロフィール項目を日本語で生成してください。
+
+ユーザー情報:
+- 氏名: {{if and .User.LastName .User.FirstName}}{{.User.LastName}} {{.User.FirstName}}{{else}}[サンプル]山田 太郎{{end}}
+- 年齢: {{if .User.Age}}{{.User.Age}}歳{{else}}[サンプル]22歳{{end}}
+- 大学: {{if .User.University}}{{.User.University}}{{else}}[サンプル]○○大学{{end}}
+- 学部: {{if .User.Faculty}}{{.User.Faculty}}{{else}}[サンプル]情報工学部{{end}}
+- 学年: {{if .User.Grade}}{{.User.Grade}}年{{else}}[サンプル]4年{{end}}
+- 志望職種: {{if .User.TargetJobType}}{{.User.TargetJobType}}{{else}}[サンプル]システムエンジニア{{end}}
+
+注意: プロフィール項目が不足している場合は、サンプルデータまたは空文字列を使用してください。
+
+JSONオブジェクトのみを返してください。マークダウンのコードブロックは使用せず、純粋なJSONで回答してください（日本語で記述）:
+`
+
+// CustomServicePromptData カスタムサービス用プロンプトに埋め込むデータ
+type CustomServicePromptData struct {
+	ServiceName string
+	User        *User
+}
+
+// BuildPrompt 項目定義からAI生成用のプロンプトテンプレートを組み立てる
+func (s *CustomService) BuildPrompt() string {
+	var b strings.Builder
+	b.WriteString(customServicePromptHeader)
+	b.WriteString("{\n")
+	for i, f := range s.Fields {
+		limit := ""
+		if f.MaxLength != nil {
+			limit = fmt.Sprintf("を%d字以内で記述", *f.MaxLength)
+		}
+
+		var example interface{} = f.Label + limit
+		if f.Type == FieldTypeList {
+			example = []string{f.Label + "1" + limit, f.Label + "2" + limit}
+		}
+		exampleJSON, _ := json.Marshal(example)
+
+		// ラベル等にテンプレート構文が含まれていても展開されないようにエスケープ
+		line := fmt.Sprintf(`  "%s": %s`, f.Key, strings.NewReplacer("{{", `{{"{{"}}`, "}}", `{{"}}"}}`).Replace(string(exampleJSON)))
+		if i < len(s.Fields)-1 {
+			line += ","
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+// エラーの種類（ドメインのエラーはいずれかをラップし、エラーのマッピングでステータスコードを決める）
+var (
+	// ErrValidation 入力が不正（400）
+	ErrValidation = errors.New("validation failed")
+	// ErrUnauthorized アクセストークンがない・不正（401）
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrNotFound 指定されたデータが存在しない（404）
+	ErrNotFound = errors.New("not found")
+	// ErrConflict 他の書き込みとの競合（409）
+	ErrConflict = errors.New("conflict")
+	// ErrPayloadTooLarge リクエストボディが上限を超えている（413）
+	ErrPayloadTooLarge = errors.New("payload too large")
+	// ErrUnsupportedMediaType リクエストボディのContent-Typeに対応していない（415）
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	// ErrUpstream 外部サービス（Gemini APIなど）の呼び出しに失敗（502）
+	ErrUpstream = errors.New("upstream service failed")
+)
+
+// エラーレスポンスのcode（クライアントが分岐に使う値。変更しないこと）
+const (
+	ErrorCodeValidation         = "validation_error"
+	ErrorCodeUnauthorized       = "unauthorized"
+	ErrorCodeForbidden          = "forbidden"
+	ErrorCodeNotFound           = "not_found"
+	ErrorCodeConflict           = "conflict"
+	ErrorCodePreconditionFailed = "precondition_failed"
+	ErrorCodePayloadTooLarge    = "payload_too_large"
+	ErrorCodeUnsupportedMedia   = "unsupported_media_type"
+	ErrorCodeInternal           = "internal_error"
+	ErrorCodeUpstream           = "upstream_error"
+)
+
+// ErrorResponse エラーレスポンスの本文（全エンドポイントで共通）
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+// ErrorBody エラーの内容
+type ErrorBody struct {
+	Code      string            `json:"code"`                 // エラーの種類（ErrorCode*）
+	Message   string            `json:"message"`              // 人が読むためのメッセージ（内部エラーの詳細は含めない）
+	Fields    []FieldError      `json:"fields,omitempty"`     // 項目ごとの検証エラー
+	Current   interface{}       `json:"current,omitempty"`    // 競合時の現在のレコード
+	Results   []BatchPartResult `json:"results,omitempty"`    // バッチ更新のパートごとの結果
+	RequestID string            `json:"request_id,omitempty"` // リクエストID（X-Request-ID、バッチ更新のパートのエラーでは省略）
+}
+
+// FieldError 項目ごとの検証エラー
+type FieldError struct {
+	Field   string `json:"field"`   // 項目のJSONキー（入れ子は.で連結）
+	Message string `json:"message"` // エラーの内容
+}
+
+// kindError 種類（ErrNotFoundなど）に属するドメインのエラー
+type kindError struct {
+	kind    error
+	message string
+}
+
+func (e *kindError) Error() string {
+	return e.message
+}
+
+func (e *kindError) Unwrap() error {
+	return e.kind
+}
+
+// NewKindError 種類に属するドメインのエラーを生成（メッセージは変えずに、errors.Isで種類も判定できる）
+func NewKindError(kind error, message string) error {
+	return &kindError{kind: kind, message: message}
+}
+
+// ValidationError 項目ごとの検証エラー（ErrValidationとして扱う）
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// NewValidationError 1項目の検証エラーを生成
+func NewValidationError(field, message string) *ValidationError {
+	return &ValidationError{Fields: []FieldError{{Field: field, Message: message}}}
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		messages = append(messages, field.Field+": "+field.Message)
+	}
+	return ErrValidation.Error() + ": " + strings.Join(messages, ", ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
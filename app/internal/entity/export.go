@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ExportFormatVersion エクスポートしたアーカイブの形式のバージョン（インポート時の互換性確認用）
+const ExportFormatVersion = 1
+
+// UserExport ユーザーの全データ（エクスポート用）
+type UserExport struct {
+	ExportedAt            time.Time              // エクスポート日時
+	User                  *User                  // ユーザー情報
+	Profile               *Profile               // ES（未登録の場合はnil）
+	Services              map[string]interface{} // サービスキー → サービスのエンティティ（未登録のサービスは含まない）
+	CustomServices        []CustomService        // ユーザー定義のカスタムサービス
+	OfferBoxPhotos        []OfferBoxPhoto        // OfferBoxの「私を表す写真」（画像データを含む）
+	Logs                  []Log                  // 更新ログ
+	AIGenerationHistories []AIGenerationHistory  // AI生成の実行履歴
+	FieldRevisions        []FieldRevision        // 項目ごとの変更履歴（変更前の値を含む）
+	AuditEvents           []AuditEvent           // 監査イベント
+}
+
+// ExportManifest アーカイブに含まれるファイルの一覧
+type ExportManifest struct {
+	FormatVersion int       `json:"format_version"` // アーカイブの形式のバージョン
+	ExportedAt    time.Time `json:"exported_at"`    // エクスポート日時
+	UserID        uuid.UUID `json:"user_id"`        // ユーザーID
+	Files         []string  `json:"files"`          // 含まれるファイルのパス
+}
+
+// RenderServiceMarkdown サービスのプロフィールを人が読めるMarkdownに変換
+// valueには項目キーから値（string / []string / []interface{}）を取得する関数を渡す
+func RenderServiceMarkdown(meta ServiceMetadata, value func(key string) interface{}) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", meta.DisplayName)
+
+	labels := make(map[string]string, len(meta.Fields))
+	for _, f := range meta.Fields {
+		labels[f.Key] = f.Label
+	}
+
+	for _, f := range meta.Fields {
+		// 説明の配列項目は対象の配列項目と一緒に出力する
+		if f.DescriptionOf != "" {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n## %s\n\n", markdownLabel(f))
+		if f.Type != FieldTypeList {
+			text, _ := value(f.Key).(string)
+			if text == "" {
+				b.WriteString("（未入力）\n")
+			} else {
+				b.WriteString(text + "\n")
+			}
+			continue
+		}
+
+		items := toStrings(value(f.Key))
+		if len(items) == 0 {
+			b.WriteString("（未入力）\n")
+			continue
+		}
+		paired := make(map[string][]string, len(f.PairedWith))
+		for _, key := range f.PairedWith {
+			paired[key] = toStrings(value(key))
+		}
+		for i, item := range items {
+			fmt.Fprintf(&b, "- %s\n", item)
+			for _, key := range f.PairedWith {
+				if i < len(paired[key]) && paired[key][i] != "" {
+					fmt.Fprintf(&b, "  - %s: %s\n", labels[key], paired[key][i])
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+func markdownLabel(f ServiceField) string {
+	if f.Label != "" {
+		return f.Label
+	}
+	return f.Key
+}
+
+// 配列項目の値を[]stringに変換
+func toStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case pq.StringArray:
+		return v
+	case []string:
+		return v
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if text, ok := item.(string); ok {
+				result = append(result, text)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// ExportArchive ダウンロード用のZIPアーカイブ
+type ExportArchive struct {
+	FileName string // ダウンロード時のファイル名
+	Data     []byte // ZIPファイルの内容
+}
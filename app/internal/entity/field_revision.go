@@ -0,0 +1,274 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// 変更の発生元
+const (
+	RevisionSourceManual = "manual" // フォームからの保存
+	RevisionSourceAI     = "ai"     // AI生成
+	RevisionSourceRevert = "revert" // 過去のリビジョンへの復元
+)
+
+// ProfileTargetTable ES（profiles）のログ・リビジョンのtarget_table
+const ProfileTargetTable = "profiles"
+
+// ErrInvalidRevisionTarget リビジョンの対象テーブルまたは項目が存在しない
+var ErrInvalidRevisionTarget = NewKindError(ErrValidation, "invalid revision target")
+
+// FieldRevision 項目ごとの変更履歴（変更前後の値を保持）
+type FieldRevision struct {
+	ID          uuid.UUID `gorm:"type:uuid;primarykey" json:"id"`              // リビジョンID（主キー）
+	UserID      uuid.UUID `gorm:"type:uuid;not null" json:"user_id"`           // ユーザーID
+	TargetTable string    `gorm:"not null;size:100" json:"target_table"`       // 対象テーブル名（サービスキーまたはprofiles）
+	FieldName   string    `gorm:"not null;size:100" json:"field_name"`         // 項目のJSONキー
+	OldValue    JSONValue `gorm:"type:jsonb" json:"old_value"`                 // 変更前の値（新規作成時はnull）
+	NewValue    JSONValue `gorm:"type:jsonb" json:"new_value"`                 // 変更後の値
+	Source      string    `gorm:"not null;size:20" json:"source"`              // 変更の発生元（manual / ai / revert）
+	CreatedAt   time.Time `gorm:"type:timestamptz;not null" json:"created_at"` // 変更日時
+}
+
+func (FieldRevision) TableName() string {
+	return "field_revisions"
+}
+
+// JSONValue 任意のJSON値（JSONBとして保存）
+type JSONValue json.RawMessage
+
+// Value JSONBとして保存
+func (v JSONValue) Value() (driver.Value, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	return string(v), nil
+}
+
+// Scan JSONBから読み込み
+func (v *JSONValue) Scan(src interface{}) error {
+	switch data := src.(type) {
+	case nil:
+		*v = nil
+	case []byte:
+		*v = append(JSONValue{}, data...)
+	case string:
+		*v = JSONValue(data)
+	default:
+		return fmt.Errorf("unsupported JSONB source type: %T", src)
+	}
+	return nil
+}
+
+// MarshalJSON JSONをそのまま出力
+func (v JSONValue) MarshalJSON() ([]byte, error) {
+	if len(v) == 0 {
+		return []byte("null"), nil
+	}
+	return v, nil
+}
+
+// UnmarshalJSON JSONをそのまま保持
+func (v *JSONValue) UnmarshalJSON(data []byte) error {
+	*v = append(JSONValue{}, data...)
+	return nil
+}
+
+// NewRevisionModel リビジョンの対象テーブルに対応するエンティティを生成
+func NewRevisionModel(targetTable string) (interface{}, bool) {
+	if targetTable == ProfileTargetTable {
+		return &Profile{}, true
+	}
+	if def, exists := FindService(targetTable); exists {
+		return def.NewModel(), true
+	}
+	return nil, false
+}
+
+// FindModelField エンティティの項目をJSONキーで検索
+func FindModelField(model interface{}, key string) (ServiceField, bool) {
+	for _, f := range ModelFields(model) {
+		if f.Key == key {
+			return f, true
+		}
+	}
+	return ServiceField{}, false
+}
+
+// DiffFieldRevisions 保存前後のエンティティを比較し、値が変わった項目のリビジョンを生成
+// beforeがnilの場合は新規作成として、空でない項目のみを対象とする
+func DiffFieldRevisions(userID uuid.UUID, targetTable, source string, before, after interface{}) []FieldRevision {
+	now := time.Now()
+	var revisions []FieldRevision
+	for _, f := range ModelFields(after) {
+		newValue := normalizeFieldValue(f.Value(after))
+		var oldValue interface{}
+		if before != nil && !reflect.ValueOf(before).IsNil() {
+			oldValue = normalizeFieldValue(f.Value(before))
+		} else if f.IsEmpty(after) {
+			continue
+		}
+		if oldValue != nil && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		revision := FieldRevision{
+			ID:          uuid.New(),
+			UserID:      userID,
+			TargetTable: targetTable,
+			FieldName:   f.Key,
+			NewValue:    mustJSONValue(newValue),
+			Source:      source,
+			CreatedAt:   now,
+		}
+		if oldValue != nil {
+			revision.OldValue = mustJSONValue(oldValue)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions
+}
+
+// SetFieldFromJSON リビジョンの値をエンティティの項目に設定
+func SetFieldFromJSON(model interface{}, f ServiceField, value JSONValue) error {
+	if f.List {
+		var values []string
+		if len(value) > 0 && string(value) != "null" {
+			if err := json.Unmarshal(value, &values); err != nil {
+				return fmt.Errorf("%w: %s must be an array of strings", ErrInvalidRevisionTarget, f.Key)
+			}
+		}
+		f.SetList(model, values)
+		return nil
+	}
+
+	var text string
+	if len(value) > 0 && string(value) != "null" {
+		if err := json.Unmarshal(value, &text); err != nil {
+			return fmt.Errorf("%w: %s must be a string", ErrInvalidRevisionTarget, f.Key)
+		}
+	}
+	f.SetString(model, text)
+	return nil
+}
+
+// 比較用に値を正規化（nilの配列と空配列を同一視）
+func normalizeFieldValue(value interface{}) interface{} {
+	if list, ok := value.(pq.StringArray); ok {
+		if list == nil {
+			return []string{}
+		}
+		return []string(list)
+	}
+	return value
+}
+
+func mustJSONValue(value interface{}) JSONValue {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return JSONValue(data)
+}
+
+// 差分の操作
+const (
+	DiffOpEqual  = "equal"
+	DiffOpInsert = "insert"
+	DiffOpDelete = "delete"
+)
+
+// RevisionDiffOp 差分の1単位（文字列項目は文・改行単位、配列項目は要素単位）
+type RevisionDiffOp struct {
+	Op   string `json:"op"`   // equal / insert / delete
+	Text string `json:"text"` // 対象のテキスト
+}
+
+// RevisionDiff 2つのリビジョンの差分
+type RevisionDiff struct {
+	From *FieldRevision   `json:"from"`
+	To   *FieldRevision   `json:"to"`
+	Ops  []RevisionDiffOp `json:"ops"`
+}
+
+// DiffRevisionValues 2つの値の差分を生成（LCSによる最小差分）
+func DiffRevisionValues(from, to JSONValue) []RevisionDiffOp {
+	a, b := diffTokens(from), diffTokens(to)
+
+	// lcs[i][j]: a[i:]とb[j:]の最長共通部分列の長さ
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]RevisionDiffOp, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, RevisionDiffOp{Op: DiffOpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, RevisionDiffOp{Op: DiffOpDelete, Text: a[i]})
+			i++
+		default:
+			ops = append(ops, RevisionDiffOp{Op: DiffOpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, RevisionDiffOp{Op: DiffOpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, RevisionDiffOp{Op: DiffOpInsert, Text: b[j]})
+	}
+	return ops
+}
+
+// 差分の単位に分割（配列は要素ごと、文字列は「。」と改行ごと）
+func diffTokens(value JSONValue) []string {
+	if len(value) == 0 || string(value) == "null" {
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(value, &list); err == nil {
+		return list
+	}
+
+	var text string
+	if err := json.Unmarshal(value, &text); err != nil {
+		return []string{string(value)}
+	}
+
+	var tokens []string
+	var current strings.Builder
+	for _, r := range text {
+		current.WriteRune(r)
+		if r == '。' || r == '\n' {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	if current.Len() > 0 {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
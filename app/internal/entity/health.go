@@ -0,0 +1,59 @@
+package entity
+
+import "time"
+
+// 稼働状況の確認結果
+const (
+	HealthStatusOK       = "ok"       // 正常
+	HealthStatusDegraded = "degraded" // リクエストは処理できるが、外部サービスに到達できない
+	HealthStatusError    = "error"    // リクエストを処理できない
+)
+
+// HealthCheck 依存先ごとの確認結果
+type HealthCheck struct {
+	Status    string `json:"status"`               // ok / error
+	Error     string `json:"error,omitempty"`      // 失敗した理由
+	LatencyMS int64  `json:"latency_ms,omitempty"` // 確認にかかった時間（ミリ秒）
+}
+
+// ReadinessResponse リクエストを受け付けられるかどうか（GET /readyz）
+type ReadinessResponse struct {
+	Status string                 `json:"status"` // ok / error
+	Checks map[string]HealthCheck `json:"checks"` // database・migrations・llm
+}
+
+// DBPoolStats DBコネクションプールの状態（sql.DB.Stats()）
+type DBPoolStats struct {
+	MaxOpenConnections int   `json:"max_open_connections"` // 最大接続数（0は無制限）
+	OpenConnections    int   `json:"open_connections"`     // 確立済みの接続数
+	InUse              int   `json:"in_use"`               // 使用中の接続数
+	Idle               int   `json:"idle"`                 // 待機中の接続数
+	WaitCount          int64 `json:"wait_count"`           // 接続の空きを待った回数
+	WaitDurationMS     int64 `json:"wait_duration_ms"`     // 接続の空きを待った合計時間（ミリ秒）
+	MaxIdleClosed      int64 `json:"max_idle_closed"`      // 待機数の上限により閉じた接続数
+	MaxIdleTimeClosed  int64 `json:"max_idle_time_closed"` // 待機時間の上限により閉じた接続数
+	MaxLifetimeClosed  int64 `json:"max_lifetime_closed"`  // 接続の寿命により閉じた接続数
+}
+
+// MigrationState マイグレーションの適用状況
+type MigrationState struct {
+	LatestVersion int64    `json:"latest_version"` // 埋め込まれた最新のバージョン
+	Pending       []string `json:"pending"`        // 未適用のマイグレーション（例: 0008_enable_pg_trgm）
+}
+
+// BuildInfo 稼働中のバイナリの情報
+type BuildInfo struct {
+	Version   string `json:"version"`    // ビルド時に埋め込んだバージョン（未指定の場合はコミットまたはdev）
+	GoVersion string `json:"go_version"` // ビルドに使ったGoのバージョン
+}
+
+// StatusResponse 依存先を含む詳細な稼働状況（GET /api/status）
+type StatusResponse struct {
+	Status        string                 `json:"status"` // ok / degraded / error
+	Build         BuildInfo              `json:"build"`
+	StartedAt     time.Time              `json:"started_at"`     // 起動日時
+	UptimeSeconds int64                  `json:"uptime_seconds"` // 起動からの経過秒数
+	Checks        map[string]HealthCheck `json:"checks"`         // readyzの確認に加え、外部サービス（gemini）への到達性
+	Database      *DBPoolStats           `json:"database"`       // 取得できない場合はnull
+	Migrations    *MigrationState        `json:"migrations"`     // 取得できない場合はnull
+}
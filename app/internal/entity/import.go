@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidImportArchive インポートするアーカイブが不正（形式・スキーマの不一致）
+	ErrInvalidImportArchive = NewKindError(ErrValidation, "invalid import archive")
+	// ErrFieldTooLong 文字列項目がgormタグのsizeを超えている
+	ErrFieldTooLong = NewKindError(ErrValidation, "field too long")
+)
+
+// インポートによる変更の種類
+const (
+	ImportActionCreate    = "create"
+	ImportActionUpdate    = "update"
+	ImportActionUnchanged = "unchanged"
+)
+
+// ImportResult インポート結果（dry-runの場合は適用予定の差分）
+type ImportResult struct {
+	UserID       uuid.UUID      `json:"user_id"`        // インポート先のユーザーID
+	SourceUserID uuid.UUID      `json:"source_user_id"` // エクスポート元のユーザーID
+	DryRun       bool           `json:"dry_run"`        // dry-runかどうか
+	Applied      bool           `json:"applied"`        // 変更を適用したか
+	Changes      []ImportChange `json:"changes"`        // レコードごとの差分
+	Skipped      []ImportSkip   `json:"skipped"`        // 復元しなかったファイル
+}
+
+// ImportSkip アーカイブに含まれるが復元しないファイル（履歴はインポート時の保存で記録し直す）
+type ImportSkip struct {
+	File    string `json:"file"`    // アーカイブ内のファイルパス
+	Records int    `json:"records"` // 含まれていたレコード数
+	Reason  string `json:"reason"`  // 復元しない理由
+}
+
+// ImportChange レコード単位の差分
+type ImportChange struct {
+	Table  string            `json:"table"`            // テーブル名
+	Key    string            `json:"key"`              // レコードの識別子
+	Action string            `json:"action"`           // create / update / unchanged
+	Fields []ImportFieldDiff `json:"fields,omitempty"` // 変更される項目
+}
+
+// AuditChanges 適用した差分を監査イベントの変更に変換（変更のないレコードは含まない）
+func (r *ImportResult) AuditChanges() AuditChanges {
+	changes := make(AuditChanges, 0, len(r.Changes))
+	for _, change := range r.Changes {
+		if change.Action == ImportActionUnchanged {
+			continue
+		}
+		fields := make([]string, 0, len(change.Fields))
+		for _, diff := range change.Fields {
+			fields = append(fields, diff.Field)
+		}
+		changes = append(changes, AuditChange{TargetTable: change.Table, Fields: fields, Source: AuditSourceImport})
+	}
+	return changes
+}
+
+// ImportFieldDiff 項目単位の差分
+type ImportFieldDiff struct {
+	Field  string      `json:"field"`  // 項目のJSONキー
+	Before interface{} `json:"before"` // 現在の値（新規作成の場合はnull）
+	After  interface{} `json:"after"`  // インポート後の値
+}
+
+// ValidateModelSizes エンティティの文字列項目がgormタグのsizeに収まっているか検証
+func ValidateModelSizes(model interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(model))
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		size := gormSize(sf.Tag.Get("gorm"))
+		if size == nil || sf.Type.Kind() != reflect.String {
+			continue
+		}
+		if utf8.RuneCountInString(v.Field(i).String()) > *size {
+			key := strings.Split(sf.Tag.Get("json"), ",")[0]
+			return fmt.Errorf("%w: %s.%s exceeds maximum length of %d characters", ErrFieldTooLong, t.Name(), key, *size)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ListItemsKey 一覧項目のJSONキー（項目のメタデータには含めない）
+const ListItemsKey = "items"
+
+var (
+	// ErrInvalidListItem 一覧項目の値が不正（一覧にない項目、主となる値が空、配列の長さが揃っていないなど）
+	ErrInvalidListItem = NewKindError(ErrValidation, "invalid list item")
+	// ErrListItemNotFound 指定された一覧または項目が存在しない
+	ErrListItemNotFound = NewKindError(ErrNotFound, "list item not found")
+)
+
+// ListItem 一覧項目の1件（スキルとその説明など、対になる配列項目の値をまとめたもの）
+// JSONでは{"id": "...", "skills": "Go", "skill_descriptions": "..."}のように項目キーを平坦に持つ
+type ListItem struct {
+	ID     uuid.UUID         // 項目ID（並べ替え・編集をしても変わらない）
+	Values map[string]string // 項目キーごとの値
+}
+
+// MarshalJSON IDと各項目の値を1つのオブジェクトとして出力
+func (i ListItem) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, len(i.Values)+1)
+	for key, value := range i.Values {
+		m[key] = value
+	}
+	m["id"] = i.ID
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON IDと各項目の値を読み込み
+func (i *ListItem) UnmarshalJSON(data []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+
+	item := ListItem{Values: make(map[string]string, len(m))}
+	for key, raw := range m {
+		if key == "id" {
+			if err := json.Unmarshal(raw, &item.ID); err != nil {
+				return fmt.Errorf("%w: id must be a UUID", ErrInvalidListItem)
+			}
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return fmt.Errorf("%w: %s must be a string", ErrInvalidListItem, key)
+		}
+		item.Values[key] = value
+	}
+	*i = item
+	return nil
+}
+
+// ListGroups 一覧ごとの項目（キーは一覧の主となる配列項目のJSONキー、JSONBとして保存）
+type ListGroups map[string][]ListItem
+
+// Value JSONBとして保存
+func (g ListGroups) Value() (driver.Value, error) {
+	if g == nil {
+		return "{}", nil
+	}
+	return marshalJSONB(g)
+}
+
+// Scan JSONBから読み込み
+func (g *ListGroups) Scan(src interface{}) error {
+	return unmarshalJSONB(src, g)
+}
+
+// ListItemRequest 一覧項目の追加・更新リクエスト
+type ListItemRequest struct {
+	Values   map[string]string `json:"values" binding:"required"` // 項目キーごとの値（更新時は含まれる項目のみ更新）
+	Position *int              `json:"position,omitempty"`        // 追加位置（0始まり、省略時は末尾）
+}
+
+// ReorderListItemsRequest 一覧項目の並べ替えリクエスト
+type ReorderListItemsRequest struct {
+	ItemIDs []uuid.UUID `json:"item_ids" binding:"required"` // 並べ替え後の順の項目ID（全項目を指定）
+}
+
+// ListGroup 対になる配列項目をまとめた一覧の定義
+type ListGroup struct {
+	Key    string         // 一覧のキー（主となる配列項目のJSONキー、例: skills）
+	Fields []ServiceField // 一覧を構成する配列項目（主となる項目が先頭）
+}
+
+// ModelListGroups エンティティの一覧の定義（pairタグから生成）
+func ModelListGroups(model interface{}) []ListGroup {
+	fields := ModelFields(model)
+	byKey := make(map[string]ServiceField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+
+	var groups []ListGroup
+	for _, f := range fields {
+		if len(f.PairedWith) == 0 {
+			continue
+		}
+		group := ListGroup{Key: f.Key, Fields: []ServiceField{f}}
+		for _, paired := range f.PairedWith {
+			group.Fields = append(group.Fields, byKey[paired])
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// FindListGroup エンティティの一覧をキーで検索
+func FindListGroup(model interface{}, key string) (ListGroup, bool) {
+	for _, group := range ModelListGroups(model) {
+		if group.Key == key {
+			return group, true
+		}
+	}
+	return ListGroup{}, false
+}
+
+// HasListItems エンティティが一覧項目（Itemsフィールド）を持つかどうか
+func HasListItems(model interface{}) bool {
+	field := reflect.ValueOf(model).Elem().FieldByName("Items")
+	return field.IsValid() && field.Type() == reflect.TypeOf(ListGroups{})
+}
+
+// ModelListItems エンティティの一覧項目を取得（持たない場合・nilの場合はnil）
+func ModelListItems(model interface{}) ListGroups {
+	if model == nil || reflect.ValueOf(model).IsNil() || !HasListItems(model) {
+		return nil
+	}
+	return reflect.ValueOf(model).Elem().FieldByName("Items").Interface().(ListGroups)
+}
+
+// SetModelListItems エンティティの一覧項目を設定
+func SetModelListItems(model interface{}, groups ListGroups) {
+	reflect.ValueOf(model).Elem().FieldByName("Items").Set(reflect.ValueOf(groups))
+}
+
+// SetListItemsFrom 保存前のエンティティの一覧項目を引き継ぐ（項目IDを維持するため、beforeがnilの場合は空にする）
+func SetListItemsFrom(model, before interface{}) {
+	if !HasListItems(model) {
+		return
+	}
+	SetModelListItems(model, ModelListItems(before))
+}
+
+// PackListItems 配列項目の値から一覧項目を組み立てる（保存前に呼び出す）
+// 組み立て前の一覧項目と主となる値または位置が一致する項目はIDを引き継ぐ
+// 説明などの配列が主となる配列より長い場合はErrInvalidListItemを返す
+func PackListItems(model interface{}) error {
+	if !HasListItems(model) {
+		return nil
+	}
+
+	previous := ModelListItems(model)
+	groups := make(ListGroups)
+	for _, group := range ModelListGroups(model) {
+		primary := listValues(model, group.Fields[0])
+		for _, f := range group.Fields[1:] {
+			if values := listValues(model, f); len(values) > len(primary) {
+				return fmt.Errorf("%w: %s has %d items but %s has %d", ErrInvalidListItem, f.Key, len(values), group.Key, len(primary))
+			}
+		}
+
+		ids := reuseListItemIDs(previous[group.Key], group.Key, primary)
+		items := make([]ListItem, len(primary))
+		for i := range primary {
+			items[i] = ListItem{ID: ids[i], Values: make(map[string]string, len(group.Fields))}
+			for _, f := range group.Fields {
+				if values := listValues(model, f); i < len(values) {
+					items[i].Values[f.Key] = values[i]
+				} else {
+					items[i].Values[f.Key] = ""
+				}
+			}
+		}
+		groups[group.Key] = items
+	}
+
+	SetModelListItems(model, groups)
+	// 短い配列を空文字で埋めて、配列項目と一覧項目を揃える
+	UnpackListItems(model)
+	return nil
+}
+
+// UnpackListItems 一覧項目から配列項目の値を設定する（読み込み後・項目の操作後に呼び出す）
+func UnpackListItems(model interface{}) {
+	if !HasListItems(model) {
+		return
+	}
+
+	groups := ModelListItems(model)
+	for _, group := range ModelListGroups(model) {
+		items := groups[group.Key]
+		for _, f := range group.Fields {
+			if len(items) == 0 {
+				f.SetList(model, nil)
+				continue
+			}
+			values := make([]string, len(items))
+			for i, item := range items {
+				values[i] = item.Values[f.Key]
+			}
+			f.SetList(model, values)
+		}
+	}
+}
+
+// AlignListItems 主となる配列より長い説明などの配列を切り詰める（AI生成結果の保存用）
+func AlignListItems(model interface{}) {
+	for _, group := range ModelListGroups(model) {
+		primary := listValues(model, group.Fields[0])
+		for _, f := range group.Fields[1:] {
+			if values := listValues(model, f); len(values) > len(primary) {
+				f.SetList(model, values[:len(primary)])
+			}
+		}
+	}
+}
+
+// AddListItem 一覧に項目を追加（positionがnilまたは範囲外の場合は末尾）
+func AddListItem(model interface{}, listKey string, values map[string]string, position *int) (ListItem, error) {
+	group, groups, err := editableListGroup(model, listKey)
+	if err != nil {
+		return ListItem{}, err
+	}
+	if err := validateListItemValues(group, values); err != nil {
+		return ListItem{}, err
+	}
+	if values[group.Key] == "" {
+		return ListItem{}, fmt.Errorf("%w: %s is required", ErrInvalidListItem, group.Key)
+	}
+
+	item := ListItem{ID: uuid.New(), Values: make(map[string]string, len(group.Fields))}
+	for _, f := range group.Fields {
+		item.Values[f.Key] = values[f.Key]
+	}
+
+	items := groups[listKey]
+	index := len(items)
+	if position != nil && *position >= 0 && *position < len(items) {
+		index = *position
+	}
+	added := make([]ListItem, 0, len(items)+1)
+	added = append(added, items[:index]...)
+	added = append(added, item)
+	added = append(added, items[index:]...)
+	groups[listKey] = added
+
+	SetModelListItems(model, groups)
+	UnpackListItems(model)
+	return item, nil
+}
+
+// UpdateListItem 一覧の項目の値を更新（valuesに含まれる項目のみ）
+func UpdateListItem(model interface{}, listKey string, itemID uuid.UUID, values map[string]string) (ListItem, error) {
+	group, groups, err := editableListGroup(model, listKey)
+	if err != nil {
+		return ListItem{}, err
+	}
+	if err := validateListItemValues(group, values); err != nil {
+		return ListItem{}, err
+	}
+	if value, exists := values[group.Key]; exists && value == "" {
+		return ListItem{}, fmt.Errorf("%w: %s is required", ErrInvalidListItem, group.Key)
+	}
+
+	items := groups[listKey]
+	index := findListItem(items, itemID)
+	if index < 0 {
+		return ListItem{}, fmt.Errorf("%w: %s", ErrListItemNotFound, itemID)
+	}
+
+	updated := make([]ListItem, len(items))
+	copy(updated, items)
+	item := ListItem{ID: itemID, Values: make(map[string]string, len(group.Fields))}
+	for key, value := range items[index].Values {
+		item.Values[key] = value
+	}
+	for key, value := range values {
+		item.Values[key] = value
+	}
+	updated[index] = item
+	groups[listKey] = updated
+
+	SetModelListItems(model, groups)
+	UnpackListItems(model)
+	return item, nil
+}
+
+// DeleteListItem 一覧から項目を削除（対になる値もまとめて削除される）
+func DeleteListItem(model interface{}, listKey string, itemID uuid.UUID) error {
+	_, groups, err := editableListGroup(model, listKey)
+	if err != nil {
+		return err
+	}
+
+	items := groups[listKey]
+	index := findListItem(items, itemID)
+	if index < 0 {
+		return fmt.Errorf("%w: %s", ErrListItemNotFound, itemID)
+	}
+
+	remaining := make([]ListItem, 0, len(items)-1)
+	remaining = append(remaining, items[:index]...)
+	remaining = append(remaining, items[index+1:]...)
+	groups[listKey] = remaining
+
+	SetModelListItems(model, groups)
+	UnpackListItems(model)
+	return nil
+}
+
+// ReorderListItems 一覧の項目を指定されたIDの順に並べ替え（全項目のIDを過不足なく指定する）
+func ReorderListItems(model interface{}, listKey string, itemIDs []uuid.UUID) error {
+	_, groups, err := editableListGroup(model, listKey)
+	if err != nil {
+		return err
+	}
+
+	items := groups[listKey]
+	if len(itemIDs) != len(items) {
+		return fmt.Errorf("%w: item_ids must contain all %d items of %s", ErrInvalidListItem, len(items), listKey)
+	}
+
+	reordered := make([]ListItem, 0, len(items))
+	seen := make(map[uuid.UUID]bool, len(itemIDs))
+	for _, id := range itemIDs {
+		index := findListItem(items, id)
+		if index < 0 || seen[id] {
+			return fmt.Errorf("%w: item_ids must contain all %d items of %s", ErrInvalidListItem, len(items), listKey)
+		}
+		seen[id] = true
+		reordered = append(reordered, items[index])
+	}
+	groups[listKey] = reordered
+
+	SetModelListItems(model, groups)
+	UnpackListItems(model)
+	return nil
+}
+
+// 操作対象の一覧の定義と、書き換え用に複製した一覧項目を取得
+func editableListGroup(model interface{}, listKey string) (ListGroup, ListGroups, error) {
+	group, exists := FindListGroup(model, listKey)
+	if !exists || !HasListItems(model) {
+		return ListGroup{}, nil, fmt.Errorf("%w: unknown list %s", ErrListItemNotFound, listKey)
+	}
+
+	// 変更前のエンティティ（変更履歴の比較用）と共有しないよう複製する
+	groups := make(ListGroups)
+	for key, items := range ModelListItems(model) {
+		groups[key] = items
+	}
+	return group, groups, nil
+}
+
+// 一覧にない項目キーが含まれていないか検証
+func validateListItemValues(group ListGroup, values map[string]string) error {
+	for key := range values {
+		found := false
+		for _, f := range group.Fields {
+			if f.Key == key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: %s is not a field of %s", ErrInvalidListItem, key, group.Key)
+		}
+	}
+	return nil
+}
+
+func findListItem(items []ListItem, id uuid.UUID) int {
+	for i, item := range items {
+		if item.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// 主となる値が一致する項目（並べ替え後も同じ項目とみなす）、次に同じ位置の項目（値を編集した場合）のIDを引き継ぐ
+func reuseListItemIDs(previous []ListItem, key string, values []string) []uuid.UUID {
+	ids := make([]uuid.UUID, len(values))
+	used := make([]bool, len(previous))
+
+	for i, value := range values {
+		for j, item := range previous {
+			if !used[j] && item.Values[key] == value {
+				ids[i] = item.ID
+				used[j] = true
+				break
+			}
+		}
+	}
+	for i := range values {
+		if ids[i] == uuid.Nil && i < len(previous) && !used[i] {
+			ids[i] = previous[i].ID
+			used[i] = true
+		}
+	}
+	for i := range ids {
+		if ids[i] == uuid.Nil {
+			ids[i] = uuid.New()
+		}
+	}
+	return ids
+}
+
+func listValues(model interface{}, f ServiceField) pq.StringArray {
+	values, _ := f.Value(model).(pq.StringArray)
+	return values
+}
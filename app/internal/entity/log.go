@@ -1,18 +1,22 @@
 package entity
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 // Log ログ情報（各サービスのフィールド更新履歴）
+// ユーザー・テーブル・フィールドごとに1行で、最後に更新された日時を保持する
 type Log struct {
-	ID          uuid.UUID `gorm:"type:uuid;primarykey" json:"id"`     // ログID（主キー）
-	UserID      uuid.UUID `gorm:"type:uuid" json:"user_id"`           // ユーザーID
-	TargetTable string    `gorm:"size:100" json:"target_table"`       // 対象テーブル名（どのサービスか）
-	FieldName   string    `gorm:"size:100" json:"field_name"`         // 更新されたフィールド名
-	UpdatedAt   time.Time `gorm:"type:timestamptz" json:"updated_at"` // 更新日時
+	ID          uuid.UUID `gorm:"type:uuid;primarykey" json:"id"`                                     // ログID（主キー）
+	UserID      uuid.UUID `gorm:"type:uuid;uniqueIndex:idx_logs_user_table_field" json:"user_id"`     // ユーザーID
+	TargetTable string    `gorm:"size:100;uniqueIndex:idx_logs_user_table_field" json:"target_table"` // 対象テーブル名（どのサービスか）
+	FieldName   string    `gorm:"size:100;uniqueIndex:idx_logs_user_table_field" json:"field_name"`   // 更新されたフィールド名
+	UpdatedAt   time.Time `gorm:"type:timestamptz" json:"updated_at"`                                 // 更新日時
 }
 
 func (Log) TableName() string {
@@ -21,3 +25,76 @@ func (Log) TableName() string {
 
 // LogResponse ログレスポンス用の構造体（サービス名 → フィールド名 → 更新日時のマップ）
 type LogResponse map[string]map[string]time.Time
+
+// MaxLogLimit ログの一覧の件数の上限（limitを省略した場合は全件）
+const MaxLogLimit = 500
+
+// ログの並び順のキー（先頭に-を付けると降順）
+const (
+	LogSortUpdatedAt   = "updated_at"
+	LogSortTargetTable = "target_table"
+	LogSortFieldName   = "field_name"
+)
+
+// DefaultLogSort ログの並び順の既定値（新しい順）
+const DefaultLogSort = "-" + LogSortUpdatedAt
+
+// LogSortKeys ログの並び順に指定できるキーの一覧
+var LogSortKeys = []string{LogSortUpdatedAt, LogSortTargetTable, LogSortFieldName}
+
+// ErrInvalidLogFilter ログの絞り込み条件が不正
+var ErrInvalidLogFilter = NewKindError(ErrValidation, "invalid log filter")
+
+// LogFilter ログの絞り込み条件
+type LogFilter struct {
+	TargetTables []string   // 対象テーブル（いずれかに一致）
+	FieldNames   []string   // 項目名（いずれかに一致）
+	From         *time.Time // この日時以降
+	To           *time.Time // この日時より前
+	Sort         string     // 並び順（updated_at / target_table / field_name、先頭に-を付けると降順）
+	Limit        int        // 0の場合は全件
+	Offset       int
+}
+
+// LogEntry 時系列の一覧の1件
+type LogEntry struct {
+	TargetTable string    `json:"target_table"` // 対象テーブル名（どのサービスか）
+	FieldName   string    `json:"field_name"`   // 更新されたフィールド名
+	UpdatedAt   time.Time `json:"updated_at"`   // 更新日時
+}
+
+// LogList ログの一覧（limit・offsetの範囲のログを、サービスごとのマップと時系列の一覧の両方で返す）
+type LogList struct {
+	Total    int64       `json:"total"`    // 絞り込み条件に一致する件数
+	Logs     LogResponse `json:"logs"`     // サービス名 → フィールド名 → 更新日時
+	Timeline []LogEntry  `json:"timeline"` // 並び順どおりの一覧
+}
+
+// ValidateLogFilter 絞り込み条件を検証し、並び順・件数を既定値・上限に揃える
+func ValidateLogFilter(filter *LogFilter) error {
+	if filter.Sort == "" {
+		filter.Sort = DefaultLogSort
+	}
+	if !slices.Contains(LogSortKeys, strings.TrimPrefix(filter.Sort, "-")) {
+		return fmt.Errorf("%w: unknown sort %s", ErrInvalidLogFilter, filter.Sort)
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidLogFilter)
+	}
+	if filter.Offset < 0 {
+		return fmt.Errorf("%w: offset must not be negative", ErrInvalidLogFilter)
+	}
+	if filter.Limit < 0 {
+		return fmt.Errorf("%w: limit must not be negative", ErrInvalidLogFilter)
+	}
+	if filter.Limit > MaxLogLimit {
+		filter.Limit = MaxLogLimit
+	}
+	return nil
+}
+
+// SortKey 並び順のキーと降順かどうか
+func (f LogFilter) SortKey() (key string, desc bool) {
+	key, desc = strings.CutPrefix(f.Sort, "-")
+	return key, desc
+}
@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrInvalidPatch JSON Merge Patchが不正（オブジェクトでない・存在しない項目・変更できない項目）
+var ErrInvalidPatch = NewKindError(ErrValidation, "invalid merge patch")
+
+// MergePatchContentType JSON Merge PatchのContent-Type（RFC 7396）
+const MergePatchContentType = "application/merge-patch+json"
+
+// patchImmutableKeys PATCHで変更できない項目（主キー・バージョン・一覧項目・タイムスタンプ）
+// 一覧項目は配列項目から組み立て直すため、配列項目（skillsなど）を送る
+var patchImmutableKeys = map[string]bool{
+	"id":         true,
+	"user_id":    true,
+	VersionKey:   true,
+	ListItemsKey: true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// MergePatch RFC 7396のJSON Merge Patchを適用
+// パッチにないキーはそのまま、nullのキーは削除、オブジェクト同士は再帰的にマージする
+func MergePatch(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = make(map[string]interface{})
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = MergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
+
+// ApplyMergePatch エンティティにJSON Merge Patchを適用した新しいエンティティを返す（元のエンティティは変更しない）
+// nullを指定した項目はゼロ値（空文字・空の配列・null）にクリアされる
+func ApplyMergePatch(model interface{}, patch []byte) (interface{}, error) {
+	var patchValue interface{}
+	if err := decodeJSONNumber(patch, &patchValue); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	patchObject, ok := patchValue.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: patch must be a JSON object", ErrInvalidPatch)
+	}
+
+	// 存在しない項目・変更できない項目を拒否
+	t := reflect.TypeOf(model).Elem()
+	keys := modelJSONKeys(t)
+	for key := range patchObject {
+		if !keys[key] {
+			return nil, fmt.Errorf("%w: unknown field %s", ErrInvalidPatch, key)
+		}
+		if patchImmutableKeys[key] {
+			return nil, fmt.Errorf("%w: %s cannot be changed", ErrInvalidPatch, key)
+		}
+	}
+
+	current, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := decodeJSONNumber(current, &document); err != nil {
+		return nil, err
+	}
+	merged, err := json.Marshal(MergePatch(document, patchObject))
+	if err != nil {
+		return nil, err
+	}
+
+	// 削除されたキーがゼロ値になるよう、新しいインスタンスに読み込む
+	result := reflect.New(t).Interface()
+	if err := json.Unmarshal(merged, result); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	return result, nil
+}
+
+// ChangedFields 変更前後のエンティティで値が変わった項目のJSONキーを返す（キー順）
+// beforeがnilの場合はゼロ値と比較する（主キー・バージョンなどの変更できない項目は含めない）
+func ChangedFields(before, after interface{}) []string {
+	t := reflect.TypeOf(after).Elem()
+	if before == nil || reflect.ValueOf(before).IsNil() {
+		before = reflect.New(t).Interface()
+	}
+
+	beforeValues, beforeErr := jsonObject(before)
+	afterValues, afterErr := jsonObject(after)
+	if beforeErr != nil || afterErr != nil {
+		return nil
+	}
+
+	changed := make([]string, 0)
+	for key := range modelJSONKeys(t) {
+		if patchImmutableKeys[key] {
+			continue
+		}
+		if !reflect.DeepEqual(beforeValues[key], afterValues[key]) {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// エンティティの項目のJSONキー
+func modelJSONKeys(t reflect.Type) map[string]bool {
+	keys := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		key := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+		keys[key] = true
+	}
+	return keys
+}
+
+// エンティティをJSONオブジェクトとして読み込む（nullと空の配列は同じ値として扱う）
+func jsonObject(model interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]interface{}
+	if err := decodeJSONNumber(data, &values); err != nil {
+		return nil, err
+	}
+	for key, value := range values {
+		if list, ok := value.([]interface{}); ok && len(list) == 0 {
+			values[key] = nil
+		}
+	}
+	return values, nil
+}
+
+// 数値の精度を落とさないようjson.Numberとして読み込む
+func decodeJSONNumber(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errors.New("unexpected data after JSON value")
+	}
+	return nil
+}
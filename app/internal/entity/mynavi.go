@@ -4,21 +4,10 @@ import "github.com/google/uuid"
 
 // Mynavi マイナビ用のプロフィール情報
 type Mynavi struct {
-	ID            uuid.UUID `gorm:"type:uuid;primarykey" json:"id"`  // ユーザーID（主キー）
-	SelfPromotion string    `gorm:"size:1000" json:"self_promotion"` // 自己PR文
-	FuturePlan    string    `gorm:"size:300" json:"future_plan"`     // 将来の計画・目標
-}
-
-// MynaviData マイナビ用のリクエストデータ構造体
-type MynaviData struct {
-	SelfPromotion string `json:"self_promotion"` // 自己PR文
-	FuturePlan    string `json:"future_plan"`    // 将来の計画・目標
-}
-
-// CreateMynaviRequest マイナビ作成リクエスト
-type CreateMynaviRequest struct {
-	UserID string     `json:"user_id" binding:"required"` // ユーザーID
-	Data   MynaviData `json:"data" binding:"required"`    // マイナビデータ
+	ID            uuid.UUID `gorm:"type:uuid;primarykey" json:"id"`                // ユーザーID（主キー）
+	SelfPromotion string    `gorm:"size:1000" json:"self_promotion" label:"自己PR文"` // 自己PR文
+	FuturePlan    string    `gorm:"size:300" json:"future_plan" label:"将来の計画・目標"`  // 将来の計画・目標
+	Version       int64     `gorm:"not null;default:1" json:"version"`             // 楽観的ロック用のバージョン（更新のたびに1ずつ増える）
 }
 
 func (Mynavi) TableName() string {
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// OfferBox OfferBox用のプロフィール情報
+type OfferBox struct {
+	ID                uuid.UUID      `gorm:"type:uuid;primarykey" json:"id"`                           // ユーザーID（主キー）
+	SelfPromotion     string         `gorm:"size:1000" json:"self_promotion" label:"自己PR"`             // 自己PR
+	StudentExperience string         `gorm:"size:1000" json:"student_experience" label:"学生時代に力を入れたこと"` // ガクチカ
+	Research          string         `gorm:"size:1000" json:"research" label:"研究・ゼミ"`                  // 研究・ゼミの内容
+	FutureVision      string         `gorm:"size:1000" json:"future_vision" label:"将来の夢・ビジョン"`         // 将来の夢・ビジョン
+	Skills            pq.StringArray `gorm:"-" json:"skills" label:"スキル" pair:"skill_descriptions"`    // スキル一覧
+	SkillDescriptions pq.StringArray `gorm:"-" json:"skill_descriptions" label:"スキルの詳細"`               // 各スキルの詳細説明
+	Certifications    pq.StringArray `gorm:"type:text[]" json:"certifications" label:"資格"`             // 取得資格一覧
+	PhotoCaptions     pq.StringArray `gorm:"type:text[]" json:"photo_captions" label:"私を表す写真のキャプション"`  // 私を表す写真の各キャプション（写真枠の順）
+	Items             ListGroups     `gorm:"type:jsonb;not null;default:'{}'" json:"items"`            // 一覧項目（対になる配列項目をまとめて保存し、項目IDで並べ替え・削除する）
+	Version           int64          `gorm:"not null;default:1" json:"version"`                        // 楽観的ロック用のバージョン（更新のたびに1ずつ増える）
+}
+
+func (OfferBox) TableName() string {
+	return "offerbox"
+}
+
+// AfterFind 読み込み後に一覧項目から配列項目の値を設定
+func (m *OfferBox) AfterFind(tx *gorm.DB) error {
+	UnpackListItems(m)
+	return nil
+}
+
+// 「私を表す写真」の枠数・ファイルサイズの上限
+const (
+	MaxOfferBoxPhotos     = 3
+	MaxOfferBoxPhotoBytes = 5 * 1024 * 1024
+)
+
+// OfferBoxPhotoContentTypes アップロード可能な画像形式
+var OfferBoxPhotoContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// OfferBoxPhoto 「私を表す写真」の画像（キャプションはOfferBox.PhotoCaptionsに保存）
+type OfferBoxPhoto struct {
+	UserID      uuid.UUID `gorm:"type:uuid;primarykey" json:"user_id"`    // ユーザーID
+	Slot        int       `gorm:"primarykey;check:slot >= 1" json:"slot"` // 写真枠の番号（1始まり）
+	ContentType string    `gorm:"not null;size:50" json:"content_type"`   // 画像のMIMEタイプ
+	Data        []byte    `gorm:"type:bytea;not null" json:"-"`           // 画像データ
+	Size        int       `json:"size"`                                   // ファイルサイズ（バイト）
+	UpdatedAt   time.Time `gorm:"type:timestamptz" json:"updated_at"`     // 更新日時
+}
+
+func (OfferBoxPhoto) TableName() string {
+	return "offerbox_photos"
+}
+
+// OfferBoxPhotoSlot 写真枠の情報（画像の有無とキャプション）
+type OfferBoxPhotoSlot struct {
+	Slot        int        `json:"slot"`                   // 写真枠の番号（1始まり）
+	Caption     string     `json:"caption"`                // キャプション
+	HasPhoto    bool       `json:"has_photo"`              // 画像がアップロード済みか
+	ContentType string     `json:"content_type,omitempty"` // 画像のMIMEタイプ
+	Size        int        `json:"size,omitempty"`         // ファイルサイズ（バイト）
+	URL         string     `json:"url,omitempty"`          // 画像取得用のURL
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`   // 画像の更新日時
+}
+
+// OfferBox用のプロンプトテンプレート（写真のキャプションはESの内容から生成する）
+const offerBoxPrompt = `
+あなたはOfferBoxの就活支援AIです。以下のユーザー情報とES（エントリーシート）の内容に基づいて、OfferBoxのプロフィール項目を日本語で生成してください。
+
+ユーザー情報:
+- 氏名: {{if and .LastName .FirstName}}{{.LastName}} {{.FirstName}}{{else}}[サンプル]山田 太郎{{end}}
+- 年齢: {{if .Age}}{{.Age}}歳{{else}}[サンプル]22歳{{end}}
+- 大学: {{if .University}}{{.University}}{{else}}[サンプル]○○大学{{end}}
+- 学部: {{if .Faculty}}{{.Faculty}}{{else}}[サンプル]情報工学部{{end}}
+- 学年: {{if .Grade}}{{.Grade}}年{{else}}[サンプル]4年{{end}}
+- 志望職種: {{if .TargetJobType}}{{.TargetJobType}}{{else}}[サンプル]システムエンジニア{{end}}
+
+ESの内容:
+{{if .Profile}}- キャリアビジョン: {{.Profile.CareerVision}}
+- 自己PR: {{.Profile.SelfPromotion}}
+- 学生時代に力を入れたこと: {{.Profile.StudentExperience}}
+- 研究内容: {{.Profile.Research}}
+- 製作物: {{range $i, $p := .Profile.Products}}{{if $i}}、{{end}}{{$p}}{{end}}
+- スキル: {{range $i, $s := .Profile.Skills}}{{if $i}}、{{end}}{{$s}}{{end}}
+- インターン・アルバイト経験: {{range $i, $s := .Profile.Interns}}{{if $i}}、{{end}}{{$s}}{{end}}
+- 部活・サークル・団体活動: {{.Profile.Organization}}
+- 資格: {{range $i, $s := .Profile.Certifications}}{{if $i}}、{{end}}{{$s}}{{end}}
+{{else}}- [ESが未登録のため、ユーザー情報からサンプルを作成してください]
+{{end}}
+「私を表す写真」には、人柄や経験が伝わる写真を3枚掲載します。
+ESの内容から、どのような写真を載せるとよいかが分かるキャプションを1枚につき100字以内で3件生成してください。
+
+注意: プロフィール項目が不足している場合は、サンプルデータまたは空文字列を使用してください。
+
+JSONオブジェクトのみを返してください。マークダウンのコードブロックは使用せず、純粋なJSONで回答してください（日本語で記述）:
+{
+  "self_promotion": "自己PRを1000字以内で記述",
+  "student_experience": "学生時代に力を入れたことを1000字以内で記述",
+  "research": "研究・ゼミの内容を1000字以内で記述",
+  "future_vision": "将来の夢・ビジョンを1000字以内で記述",
+  "skills": ["スキル1", "スキル2", "スキル3"],
+  "skill_descriptions": ["スキル1の具体的な経験と習熟度", "スキル2の具体的な経験と習熟度", "スキル3の具体的な経験と習熟度"],
+  "certifications": ["資格1", "資格2"],
+  "photo_captions": ["写真1のキャプションを100字以内で記述", "写真2のキャプションを100字以内で記述", "写真3のキャプションを100字以内で記述"]
+}`
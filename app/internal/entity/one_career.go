@@ -3,41 +3,31 @@ package entity
 import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 // OneCareer ワンキャリア用のプロフィール情報
 type OneCareer struct {
-	ID                           uuid.UUID      `gorm:"type:uuid;primarykey" json:"id"`                    // ユーザーID（主キー）
-	Skills                       pq.StringArray `gorm:"type:text[]" json:"skills"`                         // 保有スキル一覧
-	SkillDescriptions            pq.StringArray `gorm:"type:text[]" json:"skill_descriptions"`             // 各スキルの詳細説明
-	Researches                   pq.StringArray `gorm:"type:text[]" json:"researches"`                     // 研究一覧
-	ResearchDescriptions         pq.StringArray `gorm:"type:text[]" json:"research_descriptions"`          // 各研究の詳細説明
-	InternExperiences            pq.StringArray `gorm:"type:text[]" json:"intern_experiences"`             // インターン経験一覧
-	InternExperienceDescriptions pq.StringArray `gorm:"type:text[]" json:"intern_experience_descriptions"` // 各インターン経験の詳細説明
-	Products                     pq.StringArray `gorm:"type:text[]" json:"products"`                       // 制作物・プロダクト一覧
-	ProductDescriptions          pq.StringArray `gorm:"type:text[]" json:"product_descriptions"`           // 各制作物の詳細説明
-	EngineerAspiration           string         `gorm:"size:1000" json:"engineer_aspiration"`              // エンジニアとしての志望動機
-}
-
-// OneCareerData ワンキャリア用のリクエストデータ構造体
-type OneCareerData struct {
-	Skills                       []string `json:"skills"`                         // 保有スキル一覧
-	SkillDescriptions            []string `json:"skill_descriptions"`             // 各スキルの詳細説明
-	Researches                   []string `json:"researches"`                     // 研究一覧
-	ResearchDescriptions         []string `json:"research_descriptions"`          // 各研究の詳細説明
-	InternExperiences            []string `json:"intern_experiences"`             // インターン経験一覧
-	InternExperienceDescriptions []string `json:"intern_experience_descriptions"` // 各インターン経験の詳細説明
-	Products                     []string `json:"products"`                       // 制作物・プロダクト一覧
-	ProductDescriptions          []string `json:"product_descriptions"`           // 各制作物の詳細説明
-	EngineerAspiration           string   `json:"engineer_aspiration"`            // エンジニアとしての志望動機
-}
-
-// CreateOneCareerRequest ワンキャリア作成リクエスト
-type CreateOneCareerRequest struct {
-	UserID string        `json:"user_id" binding:"required"` // ユーザーID
-	Data   OneCareerData `json:"data" binding:"required"`    // ワンキャリアデータ
+	ID                           uuid.UUID      `gorm:"type:uuid;primarykey" json:"id"`                                                      // ユーザーID（主キー）
+	Skills                       pq.StringArray `gorm:"-" json:"skills" label:"保有スキル一覧" pair:"skill_descriptions"`                           // 保有スキル一覧
+	SkillDescriptions            pq.StringArray `gorm:"-" json:"skill_descriptions" label:"各スキルの詳細説明"`                                       // 各スキルの詳細説明
+	Researches                   pq.StringArray `gorm:"-" json:"researches" label:"研究一覧" pair:"research_descriptions"`                       // 研究一覧
+	ResearchDescriptions         pq.StringArray `gorm:"-" json:"research_descriptions" label:"各研究の詳細説明"`                                     // 各研究の詳細説明
+	InternExperiences            pq.StringArray `gorm:"-" json:"intern_experiences" label:"インターン経験一覧" pair:"intern_experience_descriptions"` // インターン経験一覧
+	InternExperienceDescriptions pq.StringArray `gorm:"-" json:"intern_experience_descriptions" label:"各インターン経験の詳細説明"`                       // 各インターン経験の詳細説明
+	Products                     pq.StringArray `gorm:"-" json:"products" label:"制作物・プロダクト一覧" pair:"product_descriptions"`                   // 制作物・プロダクト一覧
+	ProductDescriptions          pq.StringArray `gorm:"-" json:"product_descriptions" label:"各制作物の詳細説明"`                                     // 各制作物の詳細説明
+	EngineerAspiration           string         `gorm:"size:1000" json:"engineer_aspiration" label:"エンジニアとしての志望動機"`                          // エンジニアとしての志望動機
+	Items                        ListGroups     `gorm:"type:jsonb;not null;default:'{}'" json:"items"`                                       // 一覧項目（対になる配列項目をまとめて保存し、項目IDで並べ替え・削除する）
+	Version                      int64          `gorm:"not null;default:1" json:"version"`                                                   // 楽観的ロック用のバージョン（更新のたびに1ずつ増える）
 }
 
 func (OneCareer) TableName() string {
 	return "one_career"
 }
+
+// AfterFind 読み込み後に一覧項目から配列項目の値を設定
+func (m *OneCareer) AfterFind(tx *gorm.DB) error {
+	UnpackListItems(m)
+	return nil
+}
@@ -3,32 +3,41 @@ package entity
 import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 type Profile struct {
 	ID                        uuid.UUID      `gorm:"type:uuid;primarykey" json:"id"`
-	CareerVision              string         `gorm:"size:2000" json:"career_vision"`                // キャリアビジョン
-	SelfPromotion             string         `gorm:"size:5000" json:"self_promotion"`               // 自己PR
-	StudentExperience         string         `gorm:"size:5000" json:"student_experience"`           // ガクチカ
-	Research                  string         `gorm:"size:2000" json:"research"`                     // 研究内容
-	Products                  pq.StringArray `gorm:"type:text[]" json:"products"`                   // 製作物・開発経験（配列）
-	ProductDescriptions       pq.StringArray `gorm:"type:text[]" json:"product_descriptions"`       // 製作物説明（配列）
-	Skills                    pq.StringArray `gorm:"type:text[]" json:"skills"`                     // スキル（配列）
-	SkillDescriptions         pq.StringArray `gorm:"type:text[]" json:"skill_descriptions"`         // スキル説明（配列）
-	Interns                   pq.StringArray `gorm:"type:text[]" json:"interns"`                    // インターン・アルバイト経験（配列）
-	InternDescriptions        pq.StringArray `gorm:"type:text[]" json:"intern_descriptions"`        // インターン説明（配列）
-	Organization              string         `gorm:"size:2000" json:"organization"`                 // 部活・サークル・団体活動経験
-	Certifications            pq.StringArray `gorm:"type:text[]" json:"certifications"`             // 資格（配列）
-	CertificationDescriptions pq.StringArray `gorm:"type:text[]" json:"certification_descriptions"` // 資格説明（配列）
-	DesiredJobType            string         `gorm:"size:2000" json:"desired_job_type"`             // 希望職種
-	CompanySelectionCriteria  string         `gorm:"size:2000" json:"company_selection_criteria"`   // 企業選びの軸
-	EngineerAspiration        string         `gorm:"size:2000" json:"engineer_aspiration"`          // 理想のエンジニア像
+	CareerVision              string         `gorm:"size:2000" json:"career_vision" label:"キャリアビジョン"`                      // キャリアビジョン
+	SelfPromotion             string         `gorm:"size:5000" json:"self_promotion" label:"自己PR"`                         // 自己PR
+	StudentExperience         string         `gorm:"size:5000" json:"student_experience" label:"ガクチカ"`                     // ガクチカ
+	Research                  string         `gorm:"size:2000" json:"research" label:"研究内容"`                               // 研究内容
+	Products                  pq.StringArray `gorm:"-" json:"products" label:"製作物・開発経験" pair:"product_descriptions"`       // 製作物・開発経験（配列）
+	ProductDescriptions       pq.StringArray `gorm:"-" json:"product_descriptions" label:"製作物説明"`                          // 製作物説明（配列）
+	Skills                    pq.StringArray `gorm:"-" json:"skills" label:"スキル" pair:"skill_descriptions"`                // スキル（配列）
+	SkillDescriptions         pq.StringArray `gorm:"-" json:"skill_descriptions" label:"スキル説明"`                            // スキル説明（配列）
+	Interns                   pq.StringArray `gorm:"-" json:"interns" label:"インターン・アルバイト経験" pair:"intern_descriptions"`    // インターン・アルバイト経験（配列）
+	InternDescriptions        pq.StringArray `gorm:"-" json:"intern_descriptions" label:"インターン説明"`                         // インターン説明（配列）
+	Organization              string         `gorm:"size:2000" json:"organization" label:"部活・サークル・団体活動経験"`                 // 部活・サークル・団体活動経験
+	Certifications            pq.StringArray `gorm:"-" json:"certifications" label:"資格" pair:"certification_descriptions"` // 資格（配列）
+	CertificationDescriptions pq.StringArray `gorm:"-" json:"certification_descriptions" label:"資格説明"`                     // 資格説明（配列）
+	DesiredJobType            string         `gorm:"size:2000" json:"desired_job_type" label:"希望職種"`                       // 希望職種
+	CompanySelectionCriteria  string         `gorm:"size:2000" json:"company_selection_criteria" label:"企業選びの軸"`           // 企業選びの軸
+	EngineerAspiration        string         `gorm:"size:2000" json:"engineer_aspiration" label:"理想のエンジニア像"`               // 理想のエンジニア像
+	Items                     ListGroups     `gorm:"type:jsonb;not null;default:'{}'" json:"items"`                        // 一覧項目（対になる配列項目をまとめて保存し、項目IDで並べ替え・削除する）
+	Version                   int64          `gorm:"not null;default:1" json:"version"`                                    // 楽観的ロック用のバージョン（更新のたびに1ずつ増える）
 }
 
 func (Profile) TableName() string {
 	return "profiles"
 }
 
+// AfterFind 読み込み後に一覧項目から配列項目の値を設定
+func (m *Profile) AfterFind(tx *gorm.DB) error {
+	UnpackListItems(m)
+	return nil
+}
+
 // リクエスト用の構造体
 type ProfileData struct {
 	CareerVision              string   `json:"career_vision"`
@@ -0,0 +1,164 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidSearchQuery 検索キーワードが空または長すぎる
+var ErrInvalidSearchQuery = NewKindError(ErrValidation, "invalid search query")
+
+const (
+	MaxSearchQueryLength = 100 // 検索キーワードの最大文字数
+	DefaultSearchLimit   = 20  // 検索結果の件数（既定値）
+	MaxSearchLimit       = 100 // 検索結果の最大件数
+
+	// ProfileDisplayName ES（profiles）の表示名
+	ProfileDisplayName = "ES"
+
+	// searchSnippetRadius スニペットに含めるキーワード前後の文字数
+	searchSnippetRadius = 40
+)
+
+// SearchHit 検索にヒットした項目の値（配列項目・一覧項目は要素ごと）
+type SearchHit struct {
+	TargetTable string     // profilesまたはサービスキー
+	FieldName   string     // 項目のJSONキー
+	ItemID      *uuid.UUID // 一覧項目の場合は項目ID
+	Content     string     // 項目の値
+	Score       float64    // 類似度（0〜1、キーワードを含む場合は1に近い）
+}
+
+// SearchResponse 検索結果
+type SearchResponse struct {
+	Query   string         `json:"query"`   // 検索キーワード
+	Total   int            `json:"total"`   // 返した件数
+	Results []SearchResult `json:"results"` // 関連度順の検索結果
+}
+
+// SearchResult 検索結果の1件（どのサービスのどの項目に書いたか）
+type SearchResult struct {
+	TargetTable string            `json:"target_table"`      // profilesまたはサービスキー
+	ServiceName string            `json:"service_name"`      // サービスの表示名（ES・サポーターズなど）
+	Field       string            `json:"field"`             // 項目のJSONキー
+	FieldLabel  string            `json:"field_label"`       // 項目の日本語ラベル
+	ItemID      *uuid.UUID        `json:"item_id,omitempty"` // 一覧項目の場合は項目ID
+	Snippet     string            `json:"snippet"`           // キーワード周辺の抜粋
+	Highlights  []SearchHighlight `json:"highlights"`        // スニペット内のキーワードの位置
+	Score       float64           `json:"score"`             // 関連度
+}
+
+// SearchHighlight スニペット内で強調する範囲（文字単位のオフセット、endは含まない）
+type SearchHighlight struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// SearchTarget 検索対象のテーブル
+type SearchTarget struct {
+	Table       string      // テーブル名（検索結果のtarget_table、サービスはサービスキーと同じ）
+	DisplayName string      // 表示名
+	Model       interface{} // 項目の定義を取得するためのエンティティ
+}
+
+// SearchTargets 検索対象のテーブル（ESと全サービス、表示順）
+func SearchTargets() []SearchTarget {
+	targets := []SearchTarget{{Table: ProfileTargetTable, DisplayName: ProfileDisplayName, Model: &Profile{}}}
+	for _, def := range Services {
+		targets = append(targets, SearchTarget{Table: def.Key, DisplayName: def.DisplayName, Model: def.NewModel()})
+	}
+	return targets
+}
+
+// FindSearchTarget テーブル名から検索対象を取得
+func FindSearchTarget(table string) (SearchTarget, bool) {
+	for _, target := range SearchTargets() {
+		if target.Table == table {
+			return target, true
+		}
+	}
+	return SearchTarget{}, false
+}
+
+// NormalizeSearchQuery 検索キーワードの前後の空白を除き、長さを検証
+func NormalizeSearchQuery(query string) (string, error) {
+	query = strings.Join(strings.Fields(query), " ")
+	if query == "" {
+		return "", fmt.Errorf("%w: q is required", ErrInvalidSearchQuery)
+	}
+	if utf8.RuneCountInString(query) > MaxSearchQueryLength {
+		return "", fmt.Errorf("%w: q exceeds maximum length of %d characters", ErrInvalidSearchQuery, MaxSearchQueryLength)
+	}
+	return query, nil
+}
+
+// SearchTerms 検索キーワードを空白（全角を含む）で区切った語（すべて含む項目がヒットする）
+func SearchTerms(query string) []string {
+	return strings.Fields(query)
+}
+
+// BuildSearchSnippet 最初にキーワードが現れる位置の前後を抜粋し、スニペット内のキーワードの位置を返す
+// キーワードを含まない項目（表記ゆれによる類似一致）は先頭から抜粋する
+func BuildSearchSnippet(content string, terms []string) (string, []SearchHighlight) {
+	text := []rune(content)
+	lower := []rune(strings.ToLower(content))
+	if len(lower) != len(text) {
+		// 小文字化で文字数が変わる特殊な文字を含む場合は元の文字列で照合
+		lower = text
+	}
+
+	type match struct{ start, end int }
+	var matches []match
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(lower); i++ {
+			if string(lower[i:i+len(needle)]) == string(needle) {
+				matches = append(matches, match{i, i + len(needle)})
+				i += len(needle) - 1
+			}
+		}
+	}
+
+	// 最初のキーワードの前後を抜粋
+	first := 0
+	if len(matches) > 0 {
+		first = matches[0].start
+		for _, m := range matches {
+			if m.start < first {
+				first = m.start
+			}
+		}
+	}
+	start := first - searchSnippetRadius
+	if start < 0 {
+		start = 0
+	}
+	end := min(len(text), start+searchSnippetRadius*2)
+
+	prefix, suffix := "", ""
+	if start > 0 {
+		prefix = "…"
+	}
+	if end < len(text) {
+		suffix = "…"
+	}
+	offset := utf8.RuneCountInString(prefix) - start
+
+	highlights := make([]SearchHighlight, 0, len(matches))
+	for _, m := range matches {
+		if m.start < start || m.end > end {
+			continue
+		}
+		highlights = append(highlights, SearchHighlight{Start: m.start + offset, End: m.end + offset})
+	}
+	sort.Slice(highlights, func(i, j int) bool { return highlights[i].Start < highlights[j].Start })
+
+	return prefix + string(text[start:end]) + suffix, highlights
+}
@@ -0,0 +1,273 @@
+package entity
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ServiceDefinition 就活サービスの定義
+// 新しいサービスを追加する場合は、エンティティを作成してServicesに定義を追加するだけでよい
+// （マイグレーション・ルーティング・AI生成・ログ記録はこのレジストリを参照する）
+type ServiceDefinition struct {
+	Key         string             // サービスキー（テーブル名・ログのtarget_table・AI生成時の識別子）
+	DisplayName string             // 日本語サービス名（users.servicesに保存される名前）
+	RoutePath   string             // APIのパス（/api/{RoutePath}）
+	Prompt      string             // AI生成用のプロンプトテンプレート
+	NewModel    func() interface{} // エンティティの生成（ポインタを返す）
+}
+
+// 項目の種類
+const (
+	FieldTypeText = "text" // 文字列項目
+	FieldTypeList = "list" // 配列項目
+)
+
+// ServiceField サービスエンティティの各項目のメタデータ
+// エンティティのタグ（json・gormのsize・label・pair）から生成する
+type ServiceField struct {
+	Key           string   `json:"key"`                      // JSONキー（カラム名と同じ）
+	GoName        string   `json:"-"`                        // 構造体のフィールド名
+	Label         string   `json:"label"`                    // 日本語ラベル
+	Type          string   `json:"type"`                     // 項目の種類（text / list）
+	List          bool     `json:"-"`                        // 配列項目かどうか
+	MaxLength     *int     `json:"max_length"`               // 最大文字数（制限がない場合はnull）
+	PairedWith    []string `json:"paired_with,omitempty"`    // 対になる説明の配列項目
+	DescriptionOf string   `json:"description_of,omitempty"` // 説明の対象となる配列項目
+	Order         int      `json:"order"`                    // 表示順（1始まり）
+}
+
+// ServiceMetadata フロントエンドのフォーム生成用のサービス定義
+type ServiceMetadata struct {
+	Key         string         `json:"key"`
+	DisplayName string         `json:"display_name"`
+	Fields      []ServiceField `json:"fields"`
+}
+
+// Services 登録されている就活サービスの一覧（表示順）
+var Services = []ServiceDefinition{
+	{
+		Key:         "supporterz",
+		DisplayName: "サポーターズ",
+		RoutePath:   "supporterz",
+		Prompt:      supporterzPrompt,
+		NewModel:    func() interface{} { return &Supporterz{} },
+	},
+	{
+		Key:         "career_select",
+		DisplayName: "キャリアセレクト",
+		RoutePath:   "career-select",
+		Prompt:      careerSelectPrompt,
+		NewModel:    func() interface{} { return &CareerSelect{} },
+	},
+	{
+		Key:         "one_career",
+		DisplayName: "ワンキャリア",
+		RoutePath:   "one-career",
+		Prompt:      oneCareerPrompt,
+		NewModel:    func() interface{} { return &OneCareer{} },
+	},
+	{
+		Key:         "levtech_rookie",
+		DisplayName: "レバテックルーキー",
+		RoutePath:   "levtech-rookie",
+		Prompt:      levtechRookiePrompt,
+		NewModel:    func() interface{} { return &LevtechRookie{} },
+	},
+	{
+		Key:         "mynavi",
+		DisplayName: "マイナビ",
+		RoutePath:   "mynavi",
+		Prompt:      mynaviPrompt,
+		NewModel:    func() interface{} { return &Mynavi{} },
+	},
+	{
+		Key:         "offerbox",
+		DisplayName: "OfferBox",
+		RoutePath:   "offerbox",
+		Prompt:      offerBoxPrompt,
+		NewModel:    func() interface{} { return &OfferBox{} },
+	},
+}
+
+// CreateServiceRequest サービスデータ作成・更新リクエスト（dataの中身はサービスごとに異なる）
+type CreateServiceRequest struct {
+	UserID string          `json:"user_id" binding:"required"` // ユーザーID
+	Data   json.RawMessage `json:"data" binding:"required"`    // サービスデータ
+}
+
+// FindService サービスキーからサービス定義を取得
+func FindService(key string) (ServiceDefinition, bool) {
+	for _, def := range Services {
+		if def.Key == key {
+			return def, true
+		}
+	}
+	return ServiceDefinition{}, false
+}
+
+// FindServiceByDisplayName 日本語サービス名からサービス定義を取得
+func FindServiceByDisplayName(displayName string) (ServiceDefinition, bool) {
+	for _, def := range Services {
+		if def.DisplayName == displayName {
+			return def, true
+		}
+	}
+	return ServiceDefinition{}, false
+}
+
+// ResolveService サービスキーまたは日本語サービス名からサービス定義を取得
+func ResolveService(name string) (ServiceDefinition, bool) {
+	if def, ok := FindService(name); ok {
+		return def, true
+	}
+	return FindServiceByDisplayName(name)
+}
+
+// ServiceKeys 登録されている全サービスのキー一覧
+func ServiceKeys() []string {
+	keys := make([]string, 0, len(Services))
+	for _, def := range Services {
+		keys = append(keys, def.Key)
+	}
+	return keys
+}
+
+// ServiceDisplayNames 登録されている全サービスの日本語名一覧
+func ServiceDisplayNames() []string {
+	names := make([]string, 0, len(Services))
+	for _, def := range Services {
+		names = append(names, def.DisplayName)
+	}
+	return names
+}
+
+// サービス名変換関数
+func ConvertServiceName(japaneseName string) (string, bool) {
+	def, exists := FindServiceByDisplayName(japaneseName)
+	return def.Key, exists
+}
+
+// 逆変換関数
+func ConvertServiceNameToJapanese(englishName string) (string, bool) {
+	def, exists := FindService(englishName)
+	return def.DisplayName, exists
+}
+
+// ModelName エンティティの型名（Supporterzなど）
+func (d ServiceDefinition) ModelName() string {
+	return reflect.TypeOf(d.NewModel()).Elem().Name()
+}
+
+// Fields エンティティの構造体定義から項目のメタデータを生成（ID・バージョン・一覧項目は除く）
+func (d ServiceDefinition) Fields() []ServiceField {
+	return ModelFields(d.NewModel())
+}
+
+// ModelFields エンティティ（ポインタ）の構造体定義から項目のメタデータを生成（ID・バージョン・一覧項目は除く）
+// ESなどレジストリ外のエンティティにも使用する
+func ModelFields(model interface{}) []ServiceField {
+	t := reflect.TypeOf(model).Elem()
+	fields := make([]ServiceField, 0, t.NumField())
+	descriptionOf := make(map[string]string)
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		key := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if key == "" || key == "-" || key == "id" || key == VersionKey || key == ListItemsKey {
+			continue
+		}
+
+		field := ServiceField{
+			Key:       key,
+			GoName:    sf.Name,
+			Label:     sf.Tag.Get("label"),
+			Type:      FieldTypeText,
+			List:      sf.Type == reflect.TypeOf(pq.StringArray{}),
+			MaxLength: gormSize(sf.Tag.Get("gorm")),
+			Order:     len(fields) + 1,
+		}
+		if field.List {
+			field.Type = FieldTypeList
+		}
+		if pair := sf.Tag.Get("pair"); pair != "" {
+			field.PairedWith = strings.Split(pair, ",")
+			for _, paired := range field.PairedWith {
+				descriptionOf[paired] = key
+			}
+		}
+		fields = append(fields, field)
+	}
+
+	for i := range fields {
+		fields[i].DescriptionOf = descriptionOf[fields[i].Key]
+	}
+	return fields
+}
+
+// Metadata フロントエンド向けのサービス定義を生成
+func (d ServiceDefinition) Metadata() ServiceMetadata {
+	return ServiceMetadata{
+		Key:         d.Key,
+		DisplayName: d.DisplayName,
+		Fields:      d.Fields(),
+	}
+}
+
+// gormタグのsize指定を取得
+func gormSize(tag string) *int {
+	for _, option := range strings.Split(tag, ";") {
+		if value, ok := strings.CutPrefix(option, "size:"); ok {
+			if size, err := strconv.Atoi(value); err == nil {
+				return &size
+			}
+		}
+	}
+	return nil
+}
+
+// FieldKeys 全項目のJSONキー一覧
+func (d ServiceDefinition) FieldKeys() []string {
+	fields := d.Fields()
+	keys := make([]string, 0, len(fields))
+	for _, f := range fields {
+		keys = append(keys, f.Key)
+	}
+	return keys
+}
+
+// CloneModel エンティティ（ポインタ）を複製（配列などの中身は共有するため、書き換える場合は新しい値を設定する）
+func CloneModel(model interface{}) interface{} {
+	clone := reflect.New(reflect.TypeOf(model).Elem())
+	clone.Elem().Set(reflect.ValueOf(model).Elem())
+	return clone.Interface()
+}
+
+// SetModelID エンティティの主キー（ユーザーID）を設定
+func SetModelID(model interface{}, userID uuid.UUID) {
+	reflect.ValueOf(model).Elem().FieldByName("ID").Set(reflect.ValueOf(userID))
+}
+
+// IsEmpty エンティティの該当項目が空かどうか
+func (f ServiceField) IsEmpty(model interface{}) bool {
+	v := reflect.ValueOf(model).Elem().FieldByName(f.GoName)
+	return v.Len() == 0
+}
+
+// Value エンティティから該当項目の値を取得
+func (f ServiceField) Value(model interface{}) interface{} {
+	return reflect.ValueOf(model).Elem().FieldByName(f.GoName).Interface()
+}
+
+// SetString 文字列項目に値を設定
+func (f ServiceField) SetString(model interface{}, value string) {
+	reflect.ValueOf(model).Elem().FieldByName(f.GoName).SetString(value)
+}
+
+// SetList 配列項目に値を設定
+func (f ServiceField) SetList(model interface{}, values []string) {
+	reflect.ValueOf(model).Elem().FieldByName(f.GoName).Set(reflect.ValueOf(pq.StringArray(values)))
+}
@@ -3,45 +3,33 @@ package entity
 import (
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 // Supporterz サポーターズ用のプロフィール情報
 type Supporterz struct {
-	ID                           uuid.UUID      `gorm:"type:uuid;primarykey" json:"id"`                    // ユーザーID（主キー）
-	CareerVision                 string         `gorm:"size:200" json:"career_vision"`                     // 将来のキャリアビジョン
-	SelfPromotion                string         `gorm:"size:5000" json:"self_promotion"`                   // 自己PR文
-	Skills                       pq.StringArray `gorm:"type:text[]" json:"skills"`                         // 保有スキル一覧
-	SkillDescriptions            pq.StringArray `gorm:"type:text[]" json:"skill_descriptions"`             // 各スキルの詳細説明
-	InternExperiences            pq.StringArray `gorm:"type:text[]" json:"intern_experiences"`             // インターン経験一覧
-	InternExperienceDescriptions pq.StringArray `gorm:"type:text[]" json:"intern_experience_descriptions"` // 各インターン経験の詳細説明
-	Products                     pq.StringArray `gorm:"type:text[]" json:"products"`                       // 制作物・プロダクト一覧
-	ProductTechStacks            pq.StringArray `gorm:"type:text[]" json:"product_tech_stacks"`            // 各制作物の技術スタック
-	ProductDescriptions          pq.StringArray `gorm:"type:text[]" json:"product_descriptions"`           // 各制作物の詳細説明
-	Researches                   pq.StringArray `gorm:"type:text[]" json:"researches"`                     // 研究一覧
-	ResearchDescriptions         pq.StringArray `gorm:"type:text[]" json:"research_descriptions"`          // 各研究の詳細説明
-}
-
-// SupporterzData サポーターズ用のリクエストデータ構造体
-type SupporterzData struct {
-	CareerVision                 string   `json:"career_vision"`                  // 将来のキャリアビジョン
-	SelfPromotion                string   `json:"self_promotion"`                 // 自己PR文
-	Skills                       []string `json:"skills"`                         // 保有スキル一覧
-	SkillDescriptions            []string `json:"skill_descriptions"`             // 各スキルの詳細説明
-	InternExperiences            []string `json:"intern_experiences"`             // インターン経験一覧
-	InternExperienceDescriptions []string `json:"intern_experience_descriptions"` // 各インターン経験の詳細説明
-	Products                     []string `json:"products"`                       // 制作物・プロダクト一覧
-	ProductTechStacks            []string `json:"product_tech_stacks"`            // 各制作物の技術スタック
-	ProductDescriptions          []string `json:"product_descriptions"`           // 各制作物の詳細説明
-	Researches                   []string `json:"researches"`                     // 研究一覧
-	ResearchDescriptions         []string `json:"research_descriptions"`          // 各研究の詳細説明
-}
-
-// CreateSupporterzRequest サポーターズ作成リクエスト
-type CreateSupporterzRequest struct {
-	UserID string         `json:"user_id" binding:"required"` // ユーザーID
-	Data   SupporterzData `json:"data" binding:"required"`    // サポーターズデータ
+	ID                           uuid.UUID      `gorm:"type:uuid;primarykey" json:"id"`                                                        // ユーザーID（主キー）
+	CareerVision                 string         `gorm:"size:200" json:"career_vision" label:"将来のキャリアビジョン"`                                     // 将来のキャリアビジョン
+	SelfPromotion                string         `gorm:"size:5000" json:"self_promotion" label:"自己PR文"`                                         // 自己PR文
+	Skills                       pq.StringArray `gorm:"-" json:"skills" label:"保有スキル一覧" pair:"skill_descriptions"`                             // 保有スキル一覧
+	SkillDescriptions            pq.StringArray `gorm:"-" json:"skill_descriptions" label:"各スキルの詳細説明"`                                         // 各スキルの詳細説明
+	InternExperiences            pq.StringArray `gorm:"-" json:"intern_experiences" label:"インターン経験一覧" pair:"intern_experience_descriptions"`   // インターン経験一覧
+	InternExperienceDescriptions pq.StringArray `gorm:"-" json:"intern_experience_descriptions" label:"各インターン経験の詳細説明"`                         // 各インターン経験の詳細説明
+	Products                     pq.StringArray `gorm:"-" json:"products" label:"制作物・プロダクト一覧" pair:"product_tech_stacks,product_descriptions"` // 制作物・プロダクト一覧
+	ProductTechStacks            pq.StringArray `gorm:"-" json:"product_tech_stacks" label:"各制作物の技術スタック"`                                      // 各制作物の技術スタック
+	ProductDescriptions          pq.StringArray `gorm:"-" json:"product_descriptions" label:"各制作物の詳細説明"`                                       // 各制作物の詳細説明
+	Researches                   pq.StringArray `gorm:"-" json:"researches" label:"研究一覧" pair:"research_descriptions"`                         // 研究一覧
+	ResearchDescriptions         pq.StringArray `gorm:"-" json:"research_descriptions" label:"各研究の詳細説明"`                                       // 各研究の詳細説明
+	Items                        ListGroups     `gorm:"type:jsonb;not null;default:'{}'" json:"items"`                                         // 一覧項目（対になる配列項目をまとめて保存し、項目IDで並べ替え・削除する）
+	Version                      int64          `gorm:"not null;default:1" json:"version"`                                                     // 楽観的ロック用のバージョン（更新のたびに1ずつ増える）
 }
 
 func (Supporterz) TableName() string {
 	return "supporterz"
 }
+
+// AfterFind 読み込み後に一覧項目から配列項目の値を設定
+func (m *Supporterz) AfterFind(tx *gorm.DB) error {
+	UnpackListItems(m)
+	return nil
+}
@@ -22,6 +22,7 @@ type User struct {
 	Services      pq.StringArray `gorm:"type:text[]" json:"services"`                   // 利用する就活サービス一覧
 	CreatedAt     time.Time      `json:"created_at"`                                    // 作成日時
 	UpdatedAt     time.Time      `json:"updated_at"`                                    // 更新日時
+	Version       int64          `gorm:"not null;default:1" json:"version"`             // 楽観的ロック用のバージョン（更新のたびに1ずつ増える）
 }
 
 func (User) TableName() string {
@@ -64,3 +65,16 @@ type CreateUserRequest struct {
 	UserID string         `json:"user_id" binding:"required"`
 	Data   CreateUserData `json:"data" binding:"required"`
 }
+
+// UserServicesRequest 利用中の就活サービスの置き換えリクエスト（/api/v1、ユーザーIDはパスで指定）
+type UserServicesRequest struct {
+	Services []string `json:"services"` // 日本語サービス名（追加したサービスはAI生成する）
+}
+
+// UserDeletionReceipt アカウント削除の証明（個人情報の削除依頼への回答用）
+type UserDeletionReceipt struct {
+	UserID         uuid.UUID        `json:"user_id"`         // 削除したユーザーID
+	DeletedAt      time.Time        `json:"deleted_at"`      // 削除日時
+	DeletedRecords map[string]int64 `json:"deleted_records"` // テーブルごとの削除件数
+	TotalRecords   int64            `json:"total_records"`   // 削除したレコードの合計（usersを含む）
+}
@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"reflect"
+)
+
+// VersionKey 楽観的ロック用のバージョンのJSONキー（項目のメタデータには含めない）
+const VersionKey = "version"
+
+var (
+	// ErrVersionConflict 読み込み後に他の書き込み（フォーム・AI生成など）でレコードが更新された
+	ErrVersionConflict = NewKindError(ErrConflict, "version conflict")
+	// ErrPreconditionFailed If-Matchで指定されたバージョンが現在のバージョンと一致しない
+	ErrPreconditionFailed = NewKindError(ErrConflict, "precondition failed")
+)
+
+// ConflictError 競合時のエラー（クライアントに返す現在のレコードを保持）
+type ConflictError struct {
+	Err     error       // ErrVersionConflict または ErrPreconditionFailed
+	Current interface{} // 現在のレコード（存在しない場合はnil）
+}
+
+func (e *ConflictError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// ModelVersion エンティティのバージョンを取得（nilの場合は0）
+func ModelVersion(model interface{}) int64 {
+	if model == nil {
+		return 0
+	}
+	v := reflect.ValueOf(model)
+	if v.IsNil() {
+		return 0
+	}
+	return v.Elem().FieldByName("Version").Int()
+}
+
+// SetModelVersion エンティティのバージョンを設定
+func SetModelVersion(model interface{}, version int64) {
+	reflect.ValueOf(model).Elem().FieldByName("Version").SetInt(version)
+}
+
+// CheckVersion If-Matchで指定されたバージョンと現在のレコードを比較（expectedがnilの場合は無条件）
+func CheckVersion(expected *int64, current interface{}) error {
+	if expected == nil || *expected == ModelVersion(current) {
+		return nil
+	}
+	if current != nil && reflect.ValueOf(current).IsNil() {
+		current = nil
+	}
+	return &ConflictError{Err: ErrPreconditionFailed, Current: current}
+}
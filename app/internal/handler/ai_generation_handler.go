@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"job-hunting-service-management-backend/app/internal/entity"
 	"job-hunting-service-management-backend/app/internal/usecase"
@@ -11,6 +14,8 @@ import (
 
 type AIGenerationHandler interface {
 	GenerateServiceProfiles(c *gin.Context)
+	GenerateUserServiceProfiles(c *gin.Context)
+	GenerateCustomServiceProfile(c *gin.Context)
 }
 
 type aiGenerationHandler struct {
@@ -23,61 +28,58 @@ func NewAIGenerationHandler(aiUsecase usecase.AIGenerationUsecase) AIGenerationH
 	}
 }
 
+// GenerateServiceProfiles 旧ルート（ユーザーIDをリクエストボディで指定）
 func (h *aiGenerationHandler) GenerateServiceProfiles(c *gin.Context) {
 	var req entity.AIGenerationRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid request body",
-			"details": err.Error(),
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
+	if !authorizeUser(c, req.UserID) {
+		return
+	}
+	h.generateServiceProfiles(c, req.UserID, req.Services)
+}
 
-	// 日本語サービス名をアルファベットに変換
-	convertedServices := make([]string, 0, len(req.Services))
-	for _, service := range req.Services {
-		// まず英語名として有効かチェック
-		validEnglishServices := map[string]bool{
-			"supporterz":     true,
-			"career_select":  true,
-			"one_career":     true,
-			"mynavi":         true,
-			"levtech_rookie": true,
-		}
+// GenerateUserServiceProfiles 就活サービスのプロフィールをAIで生成（/api/v1、ユーザーIDはパスで指定）
+func (h *aiGenerationHandler) GenerateUserServiceProfiles(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	var req entity.GenerateServiceProfilesRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	h.generateServiceProfiles(c, userID, req.Services)
+}
 
-		if validEnglishServices[service] {
-			// 既に英語名の場合はそのまま使用
-			convertedServices = append(convertedServices, service)
-		} else {
-			// 日本語名の場合は変換
-			englishName, exists := entity.ConvertServiceName(service)
-			if !exists {
-				c.JSON(http.StatusBadRequest, gin.H{
-					"error":                   "Invalid service name",
-					"service":                 service,
-					"valid_services_japanese": []string{"サポーターズ", "キャリアセレクト", "ワンキャリア", "レバテックルーキー", "マイナビ"},
-					"valid_services_english":  []string{"supporterz", "career_select", "one_career", "mynavi", "levtech_rookie"},
-				})
-				return
-			}
-			convertedServices = append(convertedServices, englishName)
+func (h *aiGenerationHandler) generateServiceProfiles(c *gin.Context, userID uuid.UUID, services []string) {
+	// 日本語サービス名をアルファベットに変換
+	convertedServices := make([]string, 0, len(services))
+	for _, service := range services {
+		// 英語名・日本語名のどちらでも受け付ける
+		def, exists := entity.ResolveService(service)
+		if !exists {
+			respondInvalid(c, "services", fmt.Sprintf("unknown service %s (valid: %s)", service, strings.Join(entity.ServiceKeys(), ", ")))
+			return
 		}
+		convertedServices = append(convertedServices, def.Key)
 	}
 
 	if len(convertedServices) == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "At least one service must be specified",
-		})
+		respondInvalid(c, "services", "at least one service must be specified")
 		return
 	}
 
 	// AI生成処理を実行（変換されたサービス名を使用）
-	response, err := h.aiUsecase.GenerateServiceProfiles(c, req.UserID, convertedServices)
-	if err != nil && response.Status == "error" {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to generate service profiles",
-			"details": err.Error(),
-		})
+	response, err := h.aiUsecase.GenerateServiceProfiles(c, userID, convertedServices)
+	if err != nil {
+		respondError(c, err)
 		return
 	}
 
@@ -88,11 +90,26 @@ func (h *aiGenerationHandler) GenerateServiceProfiles(c *gin.Context) {
 		statusCode = http.StatusOK
 	case "partial_success":
 		statusCode = http.StatusPartialContent
-	case "error":
-		statusCode = http.StatusInternalServerError
 	default:
 		statusCode = http.StatusOK
 	}
 
 	c.JSON(statusCode, response)
 }
+
+// GenerateCustomServiceProfile ユーザー定義サービスの項目をAIで生成
+func (h *aiGenerationHandler) GenerateCustomServiceProfile(c *gin.Context) {
+	customServiceID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondInvalid(c, "id", "must be a UUID")
+		return
+	}
+
+	response, err := h.aiUsecase.GenerateCustomServiceProfile(c, customServiceID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
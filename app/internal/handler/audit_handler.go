@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// AuditHandler 監査イベントのHTTPハンドラー
+type AuditHandler interface {
+	GetAuditEvents(c *gin.Context)
+}
+
+type auditHandler struct {
+	au usecase.AuditUsecase
+}
+
+func NewAuditHandler(u usecase.AuditUsecase) AuditHandler {
+	return &auditHandler{au: u}
+}
+
+// GetAuditEvents GET /api/user/:userID/audit-events?actor_type=&target_table=&request_id=&from=&to=&limit=&offset=
+// from・toはRFC 3339形式の日時
+func (h *auditHandler) GetAuditEvents(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	filter := entity.AuditFilter{
+		ActorType:   c.Query("actor_type"),
+		TargetTable: c.Query("target_table"),
+		RequestID:   c.Query("request_id"),
+	}
+	for name, dest := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		if value := c.Query(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				respondInvalid(c, name, "must be an RFC 3339 timestamp")
+				return
+			}
+			*dest = &t
+		}
+	}
+	for name, dest := range map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset} {
+		if value := c.Query(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				respondInvalid(c, name, "must be a non-negative integer")
+				return
+			}
+			*dest = n
+		}
+	}
+
+	events, err := h.au.GetEvents(c, userID, filter)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// authorizeUser URL・リクエストボディのユーザーIDのデータに呼び出し元がアクセスできるか確認し、できない場合は403を返す
+// カスタムサービス・リビジョンなどIDで指定するデータは、ユースケースが所有者を確認する
+func authorizeUser(c *gin.Context, userID uuid.UUID) bool {
+	if err := entity.AuthorizeUser(c, userID); err != nil {
+		respondError(c, err)
+		return false
+	}
+	return true
+}
+
+// authorizeUserString 文字列のユーザーIDを検証してから確認（ユースケースに文字列のまま渡すハンドラー向け）
+func authorizeUserString(c *gin.Context, userID string) bool {
+	parsed, err := uuid.Parse(userID)
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return false
+	}
+	return authorizeUser(c, parsed)
+}
+
+// userIDParam パスのユーザーID（/api/v1は:userID、旧ルートのサービス・ES・写真・ログは:id）
+func userIDParam(c *gin.Context) string {
+	if userID := c.Param("userID"); userID != "" {
+		return userID
+	}
+	return c.Param("id")
+}
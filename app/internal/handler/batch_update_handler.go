@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// BatchUpdateHandler ユーザー・ES・複数の就活サービスのまとめての更新のHTTPハンドラー
+type BatchUpdateHandler interface {
+	BatchUpdate(c *gin.Context)
+}
+
+type batchUpdateHandler struct {
+	bu usecase.BatchUpdateUsecase
+}
+
+func NewBatchUpdateHandler(u usecase.BatchUpdateUsecase) BatchUpdateHandler {
+	return &batchUpdateHandler{bu: u}
+}
+
+// BatchUpdate POST /api/v1/users/:userID/batch
+// いずれかのパートが失敗した場合は何も保存せず、エラーレスポンスのresultsにパートごとの結果を返す
+func (h *batchUpdateHandler) BatchUpdate(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	var req entity.BatchUpdateRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	response, err := h.bu.Update(c, userID, req)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// CustomServiceHandler ユーザー定義の就活サービスのHTTPハンドラー
+type CustomServiceHandler interface {
+	GetCustomServicesByUserID(c *gin.Context)
+	GetCustomServiceByID(c *gin.Context)
+	CreateCustomService(c *gin.Context)
+	CreateUserCustomService(c *gin.Context)
+	UpdateCustomService(c *gin.Context)
+	DeleteCustomService(c *gin.Context)
+	SaveCustomServiceValues(c *gin.Context)
+}
+
+type customServiceHandler struct {
+	csu usecase.CustomServiceUsecase
+}
+
+func NewCustomServiceHandler(u usecase.CustomServiceUsecase) CustomServiceHandler {
+	return &customServiceHandler{csu: u}
+}
+
+func (h *customServiceHandler) GetCustomServicesByUserID(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	customServices, err := h.csu.GetCustomServicesByUserID(c, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"custom_services": customServices})
+}
+
+func (h *customServiceHandler) GetCustomServiceByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondInvalid(c, "id", "must be a UUID")
+		return
+	}
+
+	customService, err := h.csu.GetCustomServiceByID(c, id)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	if customService == nil {
+		respondError(c, fmt.Errorf("%w: %s", usecase.ErrCustomServiceNotFound, id))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"custom_service": customService,
+		"metadata":       customService.Metadata(),
+	})
+}
+
+// CreateCustomService 旧ルート（ユーザーIDをリクエストボディで指定）
+func (h *customServiceHandler) CreateCustomService(c *gin.Context) {
+	var req entity.CreateCustomServiceRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	// UUIDのパース
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	h.createCustomService(c, userID, req.Data)
+}
+
+// CreateUserCustomService カスタムサービスを作成（/api/v1、ユーザーIDはパスで指定し、リクエストボディはdataの部分のみ）
+func (h *customServiceHandler) CreateUserCustomService(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	var data entity.CustomServiceData
+	if !bindJSON(c, &data) {
+		return
+	}
+	h.createCustomService(c, userID, data)
+}
+
+func (h *customServiceHandler) createCustomService(c *gin.Context, userID uuid.UUID, data entity.CustomServiceData) {
+	customService, err := h.csu.CreateCustomService(c, userID, data)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, customService)
+}
+
+func (h *customServiceHandler) UpdateCustomService(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondInvalid(c, "id", "must be a UUID")
+		return
+	}
+
+	var req entity.CustomServiceData
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	customService, err := h.csu.UpdateCustomService(c, id, req)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, customService)
+}
+
+func (h *customServiceHandler) DeleteCustomService(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondInvalid(c, "id", "must be a UUID")
+		return
+	}
+
+	if err := h.csu.DeleteCustomService(c, id); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Custom service deleted successfully"})
+}
+
+func (h *customServiceHandler) SaveCustomServiceValues(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondInvalid(c, "id", "must be a UUID")
+		return
+	}
+
+	var req entity.SaveCustomServiceValuesRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	customService, err := h.csu.SaveCustomServiceValues(c, id, req.Data)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, customService)
+}
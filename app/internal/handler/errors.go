@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+func init() {
+	// 検証エラーの項目名をJSONキーにする（UserIDではなくuser_id）
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+}
+
+// respondError エラーをc.Errorに設定して処理を中断する（レスポンスはmiddleware.Errorsが種類に応じて返す）
+func respondError(c *gin.Context, err error) {
+	var conflict *entity.ConflictError
+	if errors.As(err, &conflict) {
+		setETag(c, conflict.Current)
+	}
+	_ = c.Error(err)
+	c.Abort()
+}
+
+// respondInvalid パスパラメータ・クエリ・ヘッダーの形式エラー
+func respondInvalid(c *gin.Context, field, message string) {
+	respondError(c, entity.NewValidationError(field, message))
+}
+
+// bindJSON リクエストボディをJSONとして読み込み、不正な場合は項目ごとの検証エラーを返す
+func bindJSON(c *gin.Context, obj interface{}) bool {
+	if err := c.ShouldBindJSON(obj); err != nil {
+		respondError(c, bindingError(err))
+		return false
+	}
+	return true
+}
+
+// bindingError JSONの読み込み・bindingタグの検証のエラーを検証エラーに変換
+func bindingError(err error) error {
+	var (
+		validationErrors validator.ValidationErrors
+		typeError        *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &validationErrors):
+		validation := &entity.ValidationError{}
+		for _, fieldError := range validationErrors {
+			validation.Fields = append(validation.Fields, entity.FieldError{
+				Field:   fieldPath(fieldError.Namespace()),
+				Message: "failed on the '" + fieldError.Tag() + "' rule",
+			})
+		}
+		return validation
+	case errors.As(err, &typeError):
+		return entity.NewValidationError(typeError.Field, "must be "+typeError.Type.String())
+	case errors.Is(err, io.EOF):
+		return entity.NewKindError(entity.ErrValidation, "request body is required")
+	default:
+		return entity.NewKindError(entity.ErrValidation, "invalid request body: "+err.Error())
+	}
+}
+
+// fieldPath 検証エラーの名前空間（CreateUserRequest.data.last_name）から構造体名を除く
+func fieldPath(namespace string) string {
+	_, path, found := strings.Cut(namespace, ".")
+	if !found {
+		return namespace
+	}
+	return path
+}
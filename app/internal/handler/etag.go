@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// setETag レコードのバージョンをETagヘッダーに設定（例: "3"）
+func setETag(c *gin.Context, model interface{}) {
+	version := entity.ModelVersion(model)
+	if version == 0 {
+		return
+	}
+	c.Header("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersion If-Matchヘッダーから更新の前提となるバージョンを取得
+// ヘッダーがない場合と「*」の場合はnil（無条件で更新）、弱いETag（W/"3"）も受け付ける
+func ifMatchVersion(c *gin.Context) (*int64, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	tag := strings.TrimPrefix(header, "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		return nil, errors.New("If-Match must be a single ETag returned by GET (e.g. \"3\")")
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return nil, errors.New("If-Match must be a single ETag returned by GET (e.g. \"3\")")
+	}
+	return &version, nil
+}
+
+// bindIfMatch If-Matchヘッダーを解析し、不正な場合は400を返す
+func bindIfMatch(c *gin.Context) (*int64, bool) {
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		respondInvalid(c, "If-Match", err.Error())
+		return nil, false
+	}
+	return version, true
+}
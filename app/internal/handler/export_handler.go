@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// ExportHandler ユーザーデータのエクスポートのHTTPハンドラー
+type ExportHandler interface {
+	ExportUser(c *gin.Context)
+}
+
+type exportHandler struct {
+	eu usecase.ExportUsecase
+}
+
+func NewExportHandler(u usecase.ExportUsecase) ExportHandler {
+	return &exportHandler{eu: u}
+}
+
+func (h *exportHandler) ExportUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	archive, err := h.eu.ExportUser(c, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	if archive == nil {
+		respondError(c, fmt.Errorf("%w: %s", usecase.ErrUserNotFound, userID))
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, archive.FileName))
+	c.Data(http.StatusOK, "application/zip", archive.Data)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// FieldRevisionHandler 項目ごとの変更履歴のHTTPハンドラー
+type FieldRevisionHandler interface {
+	GetRevisions(c *gin.Context)
+	DiffRevisions(c *gin.Context)
+	RevertToRevision(c *gin.Context)
+}
+
+type fieldRevisionHandler struct {
+	fru usecase.FieldRevisionUsecase
+}
+
+func NewFieldRevisionHandler(u usecase.FieldRevisionUsecase) FieldRevisionHandler {
+	return &fieldRevisionHandler{fru: u}
+}
+
+func (h *fieldRevisionHandler) GetRevisions(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	revisions, err := h.fru.GetRevisions(c, userID, c.Param("table"), c.Param("field"))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+func (h *fieldRevisionHandler) DiffRevisions(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	fromID, err := uuid.Parse(c.Query("from"))
+	if err != nil {
+		respondInvalid(c, "from", "must be a UUID")
+		return
+	}
+	toID, err := uuid.Parse(c.Query("to"))
+	if err != nil {
+		respondInvalid(c, "to", "must be a UUID")
+		return
+	}
+
+	diff, err := h.fru.DiffRevisions(c, userID, c.Param("table"), c.Param("field"), fromID, toID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, diff)
+}
+
+func (h *fieldRevisionHandler) RevertToRevision(c *gin.Context) {
+	revisionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		respondInvalid(c, "id", "must be a UUID")
+		return
+	}
+
+	revision, err := h.fru.RevertToRevision(c, revisionID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, revision)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// HealthHandler 稼働状況の確認のHTTPハンドラー（Renderのヘルスチェックなどから呼ぶ）
+type HealthHandler interface {
+	Healthz(c *gin.Context)
+	Readyz(c *gin.Context)
+	Status(c *gin.Context)
+}
+
+type healthHandler struct {
+	hu usecase.HealthUsecase
+}
+
+func NewHealthHandler(u usecase.HealthUsecase) HealthHandler {
+	return &healthHandler{hu: u}
+}
+
+// Healthz プロセスが応答できるか（依存先は確認しない）
+func (h *healthHandler) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": entity.HealthStatusOK})
+}
+
+// Readyz リクエストを受け付けられるか（確認に失敗した場合は503）
+func (h *healthHandler) Readyz(c *gin.Context) {
+	response := h.hu.Readiness(c)
+	c.JSON(healthStatusCode(response.Status), response)
+}
+
+// Status 依存先を含む詳細な稼働状況（外部サービスのみ到達できない場合は200でdegraded）
+func (h *healthHandler) Status(c *gin.Context) {
+	response := h.hu.Status(c)
+	c.JSON(healthStatusCode(response.Status), response)
+}
+
+func healthStatusCode(status string) int {
+	if status == entity.HealthStatusError {
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusOK
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// ImportHandler エクスポートしたアーカイブのインポートのHTTPハンドラー
+type ImportHandler interface {
+	ImportUser(c *gin.Context)
+}
+
+type importHandler struct {
+	iu usecase.ImportUsecase
+}
+
+func NewImportHandler(u usecase.ImportUsecase) ImportHandler {
+	return &importHandler{iu: u}
+}
+
+func (h *importHandler) ImportUser(c *gin.Context) {
+	// dry_run=trueの場合は差分のみ返して適用しない
+	dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		respondInvalid(c, "dry_run", "must be a boolean")
+		return
+	}
+
+	// user_idを指定した場合は別のアカウントへ移行する
+	var targetUserID *uuid.UUID
+	if userIDParam := c.Query("user_id"); userIDParam != "" {
+		userID, err := uuid.Parse(userIDParam)
+		if err != nil {
+			respondInvalid(c, "user_id", "must be a UUID")
+			return
+		}
+		targetUserID = &userID
+	}
+
+	fileHeader, err := c.FormFile("archive")
+	if err != nil {
+		respondInvalid(c, "archive", "file is required")
+		return
+	}
+	if fileHeader.Size > usecase.MaxImportArchiveBytes {
+		respondError(c, entity.NewKindError(entity.ErrPayloadTooLarge, "archive exceeds maximum size"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	defer file.Close()
+
+	archive, err := io.ReadAll(io.LimitReader(file, usecase.MaxImportArchiveBytes+1))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	result, err := h.iu.ImportUser(c, archive, targetUserID, dryRun)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -2,15 +2,22 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 
+	"job-hunting-service-management-backend/app/internal/entity"
 	"job-hunting-service-management-backend/app/internal/usecase"
 )
 
 type LogHandler interface {
+	// 旧ルート（サービス名 → フィールド名 → 更新日時のマップのみ返す）
 	GetLogsByUserID(c *gin.Context)
+	// マップに加えて件数と時系列の一覧を返す
+	ListLogs(c *gin.Context)
 }
 
 type logHandler struct {
@@ -21,27 +28,88 @@ func NewLogHandler(u usecase.LogUsecase) LogHandler {
 	return &logHandler{lu: u}
 }
 
+// GetLogsByUserID GET /api/log/:id?service=&field=&from=&to=&sort=&limit=&offset=
 func (h *logHandler) GetLogsByUserID(c *gin.Context) {
+	logs, ok := h.getLogs(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, logs.Logs)
+}
+
+// ListLogs GET /api/v1/users/:userID/logs?service=&field=&from=&to=&sort=&limit=&offset=
+func (h *logHandler) ListLogs(c *gin.Context) {
+	logs, ok := h.getLogs(c)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, logs)
+}
+
+// getLogs パスのユーザーIDとクエリの絞り込み条件でログを取得（エラーの場合はレスポンスを設定してfalseを返す）
+// service・fieldは複数指定でき（?service=a&service=bまたは?service=a,b）、from・toはRFC 3339形式の日時
+func (h *logHandler) getLogs(c *gin.Context) (*entity.LogList, bool) {
 	// パスパラメータからuser_idを取得
-	userIDStr := c.Param("id")
+	userIDStr := userIDParam(c)
 	if userIDStr == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "User ID is required"})
-		return
+		respondInvalid(c, "id", "is required")
+		return nil, false
 	}
 
 	// UUIDのパース
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
-		return
+		respondInvalid(c, "user_id", "must be a UUID")
+		return nil, false
+	}
+	if !authorizeUser(c, userID) {
+		return nil, false
+	}
+
+	filter := entity.LogFilter{
+		TargetTables: queryList(c, "service"),
+		FieldNames:   queryList(c, "field"),
+		Sort:         c.Query("sort"),
+	}
+	for name, dest := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		if value := c.Query(name); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				respondInvalid(c, name, "must be an RFC 3339 timestamp")
+				return nil, false
+			}
+			*dest = &t
+		}
+	}
+	for name, dest := range map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset} {
+		if value := c.Query(name); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				respondInvalid(c, name, "must be a non-negative integer")
+				return nil, false
+			}
+			*dest = n
+		}
 	}
 
 	// ログ情報を取得
-	logs, err := h.lu.GetLogsByUserID(c, userID)
+	logs, err := h.lu.GetLogs(c, userID, filter)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
+		respondError(c, err)
+		return nil, false
 	}
+	return logs, true
+}
 
-	c.JSON(http.StatusOK, logs)
+// queryList 複数指定できるクエリパラメータ（繰り返しとカンマ区切りの両方に対応し、空の値は除く）
+func queryList(c *gin.Context, name string) []string {
+	var values []string
+	for _, value := range c.QueryArray(name) {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
 }
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"mime"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// bindMergePatch JSON Merge Patchのリクエストボディを読み込み、不正な場合は400または415を返す
+// Content-Typeはapplication/merge-patch+jsonとapplication/jsonを受け付ける
+func bindMergePatch(c *gin.Context) ([]byte, bool) {
+	mediaType, _, err := mime.ParseMediaType(c.GetHeader("Content-Type"))
+	if err != nil || (mediaType != entity.MergePatchContentType && mediaType != "application/json") {
+		respondError(c, entity.NewKindError(entity.ErrUnsupportedMediaType, "Content-Type must be "+entity.MergePatchContentType))
+		return nil, false
+	}
+
+	patch, err := c.GetRawData()
+	if err != nil {
+		respondError(c, err)
+		return nil, false
+	}
+	if len(patch) == 0 {
+		respondError(c, entity.NewKindError(entity.ErrValidation, "request body is required"))
+		return nil, false
+	}
+	// 文字化け対策
+	if !utf8.Valid(patch) {
+		respondError(c, entity.NewKindError(entity.ErrValidation, "invalid character encoding"))
+		return nil, false
+	}
+	return patch, true
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// OfferBoxPhotoHandler OfferBoxの「私を表す写真」のHTTPハンドラー
+type OfferBoxPhotoHandler interface {
+	GetPhotoSlots(c *gin.Context)
+	GetPhoto(c *gin.Context)
+	UploadPhoto(c *gin.Context)
+	DeletePhoto(c *gin.Context)
+}
+
+type offerBoxPhotoHandler struct {
+	opu usecase.OfferBoxPhotoUsecase
+}
+
+func NewOfferBoxPhotoHandler(u usecase.OfferBoxPhotoUsecase) OfferBoxPhotoHandler {
+	return &offerBoxPhotoHandler{opu: u}
+}
+
+func (h *offerBoxPhotoHandler) GetPhotoSlots(c *gin.Context) {
+	userID, err := uuid.Parse(userIDParam(c))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	slots, err := h.opu.GetPhotoSlots(c, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"photos": slots})
+}
+
+func (h *offerBoxPhotoHandler) GetPhoto(c *gin.Context) {
+	userID, slot, ok := parseOfferBoxPhotoParams(c)
+	if !ok {
+		return
+	}
+
+	photo, err := h.opu.GetPhoto(c, userID, slot)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, photo.ContentType, photo.Data)
+}
+
+func (h *offerBoxPhotoHandler) UploadPhoto(c *gin.Context) {
+	userID, slot, ok := parseOfferBoxPhotoParams(c)
+	if !ok {
+		return
+	}
+
+	fileHeader, err := c.FormFile("photo")
+	if err != nil {
+		respondInvalid(c, "photo", "file is required")
+		return
+	}
+	if fileHeader.Size > entity.MaxOfferBoxPhotoBytes {
+		respondError(c, entity.NewKindError(entity.ErrPayloadTooLarge, "photo exceeds maximum size"))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, entity.MaxOfferBoxPhotoBytes+1))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	photo, err := h.opu.SavePhoto(c, userID, slot, data)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, photo)
+}
+
+func (h *offerBoxPhotoHandler) DeletePhoto(c *gin.Context) {
+	userID, slot, ok := parseOfferBoxPhotoParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.opu.DeletePhoto(c, userID, slot); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Photo deleted successfully"})
+}
+
+// URLパラメータからユーザーIDと写真枠の番号を取得
+func parseOfferBoxPhotoParams(c *gin.Context) (uuid.UUID, int, bool) {
+	userID, err := uuid.Parse(userIDParam(c))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return uuid.Nil, 0, false
+	}
+	if !authorizeUser(c, userID) {
+		return uuid.Nil, 0, false
+	}
+	slot, err := strconv.Atoi(c.Param("slot"))
+	if err != nil {
+		respondInvalid(c, "slot", "must be an integer")
+		return uuid.Nil, 0, false
+	}
+	return userID, slot, true
+}
@@ -17,6 +17,12 @@ import (
 type ProfileHandler interface {
 	GetProfileByUserID(c *gin.Context)    // ユーザーIDでプロフィール情報を取得
 	CreateOrUpdateProfile(c *gin.Context) // プロフィール情報を作成または更新
+	PutProfile(c *gin.Context)            // プロフィール情報を作成または置き換え（/api/v1）
+	PatchProfile(c *gin.Context)          // プロフィール情報を部分更新（JSON Merge Patch）
+	AddListItem(c *gin.Context)           // 一覧に項目を追加（製作物とその説明などをまとめて追加）
+	UpdateListItem(c *gin.Context)        // 一覧の項目の値を更新
+	DeleteListItem(c *gin.Context)        // 一覧から項目を削除（対になる説明も一緒に削除）
+	ReorderListItems(c *gin.Context)      // 一覧の項目を並べ替え
 }
 
 type profileHandler struct {
@@ -31,131 +37,299 @@ func NewProfileHandler(u usecase.ProfileUsecase) ProfileHandler {
 // GetProfileByUserID はユーザーIDに基づいてプロフィール情報を取得します
 func (h *profileHandler) GetProfileByUserID(c *gin.Context) {
 	// URLパラメータからIDを取得
-	idParam := c.Param("id")
-	if idParam == "" {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "User ID parameter is required",
-		})
-		return
-	}
+	idParam := userIDParam(c)
 
-	// UUIDのパース
+	// UUIDのパース（空のユーザーIDも不正とする）
 	userID, err := uuid.Parse(idParam)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid user ID format",
-			"details": "User ID must be a valid UUID",
-		})
+	if err != nil || userID == uuid.Nil {
+		respondInvalid(c, "user_id", "must be a UUID")
 		return
 	}
-
-	// 空のユーザーIDチェック
-	if userID == uuid.Nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "User ID cannot be empty",
-		})
+	if !authorizeUser(c, userID) {
 		return
 	}
 
 	// プロフィール取得
 	profile, err := h.pu.GetProfileByUserID(c, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to retrieve profile",
-			"details": err.Error(),
-		})
+		respondError(c, err)
 		return
 	}
 
 	// プロフィールが見つからない場合
 	if profile == nil {
-		c.JSON(http.StatusNotFound, gin.H{
-			"message": "Profile not found for the specified user",
-		})
+		respondError(c, fmt.Errorf("%w: %s", usecase.ErrProfileNotFound, userID))
 		return
 	}
 
 	// 成功レスポンス（UTF-8で明示的に設定）
 	c.Header("Content-Type", "application/json; charset=utf-8")
+	setETag(c, profile)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Profile retrieved successfully",
 		"profile": profile,
 	})
 }
 
-// CreateOrUpdateProfile はプロフィール情報を作成または更新します
+// CreateOrUpdateProfile はプロフィール情報を作成または更新します（旧ルート、ユーザーIDをリクエストボディで指定）
 func (h *profileHandler) CreateOrUpdateProfile(c *gin.Context) {
 	// Content-Typeの確認（文字化け対策）
-	contentType := c.GetHeader("Content-Type")
-	if !strings.Contains(contentType, "application/json") {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Content-Type must be application/json",
-		})
+	if !requireJSONContentType(c) {
 		return
 	}
 
 	var req entity.CreateProfileRequest
 
 	// JSONリクエストボディのバインド
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid request format",
-			"details": err.Error(),
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	// UTF-8エンコーディングの検証（文字化け対策）
-	if err := h.validateUTF8Encoding(req.Data); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid character encoding",
-			"details": err.Error(),
-		})
+	// ユーザーIDの検証（空のユーザーIDも不正とする）
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil || userID == uuid.Nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
 		return
 	}
 
-	// ユーザーIDの検証
-	userID, err := uuid.Parse(req.UserID)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Invalid user ID format",
-			"details": "User ID must be a valid UUID",
-		})
+	h.saveProfile(c, userID, req.Data)
+}
+
+// PutProfile はプロフィール情報を作成または置き換えます（/api/v1、ユーザーIDはパスで指定し、リクエストボディはdataの部分のみ）
+func (h *profileHandler) PutProfile(c *gin.Context) {
+	userID, err := uuid.Parse(userIDParam(c))
+	if err != nil || userID == uuid.Nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	if !requireJSONContentType(c) {
+		return
+	}
+	var data entity.ProfileData
+	if !bindJSON(c, &data) {
+		return
+	}
+
+	h.saveProfile(c, userID, data)
+}
+
+// saveProfile プロフィールを保存してレスポンスを返す（旧ルートと/api/v1で共通）
+func (h *profileHandler) saveProfile(c *gin.Context, userID uuid.UUID, data entity.ProfileData) {
+	// UTF-8エンコーディングの検証（文字化け対策）
+	if err := h.validateUTF8Encoding(data); err != nil {
+		respondError(c, err)
 		return
 	}
 
-	// 空のユーザーIDチェック
-	if userID == uuid.Nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "User ID cannot be empty",
-		})
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
 		return
 	}
 
 	// プロフィールの作成または更新
-	profile, err := h.pu.CreateOrUpdateProfile(c, userID, req.Data)
+	profile, err := h.pu.CreateOrUpdateProfile(c, userID, data, expectedVersion)
 	if err != nil {
-		// エラーの種類に応じてステータスコードを決定
-		statusCode := http.StatusInternalServerError
-		if strings.Contains(err.Error(), "validation failed") {
-			statusCode = http.StatusBadRequest
-		}
-
-		c.JSON(statusCode, gin.H{
-			"error":   "Failed to create or update profile",
-			"details": err.Error(),
-		})
+		respondError(c, err)
 		return
 	}
 
 	// 成功レスポンス（UTF-8で明示的に設定）
 	c.Header("Content-Type", "application/json; charset=utf-8")
+	setETag(c, profile)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Profile created/updated successfully",
 		"profile": profile,
 	})
 }
 
+// requireJSONContentType Content-TypeがJSONでない場合は415を返す
+func requireJSONContentType(c *gin.Context) bool {
+	if !strings.Contains(c.GetHeader("Content-Type"), "application/json") {
+		respondError(c, entity.NewKindError(entity.ErrUnsupportedMediaType, "Content-Type must be application/json"))
+		return false
+	}
+	return true
+}
+
+// PatchProfile はJSON Merge Patchでプロフィール情報を部分更新します
+// キーがない項目はそのまま、nullを指定した項目は空にクリアされます
+func (h *profileHandler) PatchProfile(c *gin.Context) {
+	// ユーザーIDの検証
+	userID, err := uuid.Parse(userIDParam(c))
+	if err != nil || userID == uuid.Nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	patch, ok := bindMergePatch(c)
+	if !ok {
+		return
+	}
+
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.pu.PatchProfile(c, userID, patch, expectedVersion)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	// 成功レスポンス（UTF-8で明示的に設定）
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	setETag(c, profile)
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Profile patched successfully",
+		"profile": profile,
+	})
+}
+
+// AddListItem は一覧に項目を追加します
+func (h *profileHandler) AddListItem(c *gin.Context) {
+	userID, ok := bindProfileUserID(c)
+	if !ok {
+		return
+	}
+
+	var req entity.ListItemRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.pu.AddListItem(c, userID, c.Param("list"), req.Values, req.Position, expectedVersion)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondProfile(c, http.StatusCreated, "List item added successfully", profile)
+}
+
+// UpdateListItem は一覧の項目の値を更新します（valuesに含まれる項目のみ）
+func (h *profileHandler) UpdateListItem(c *gin.Context) {
+	userID, ok := bindProfileUserID(c)
+	if !ok {
+		return
+	}
+
+	itemID, err := uuid.Parse(c.Param("itemID"))
+	if err != nil {
+		respondInvalid(c, "itemID", "must be a UUID")
+		return
+	}
+
+	var req entity.ListItemRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.pu.UpdateListItem(c, userID, c.Param("list"), itemID, req.Values, expectedVersion)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondProfile(c, http.StatusOK, "List item updated successfully", profile)
+}
+
+// DeleteListItem は一覧から項目を削除します
+func (h *profileHandler) DeleteListItem(c *gin.Context) {
+	userID, ok := bindProfileUserID(c)
+	if !ok {
+		return
+	}
+
+	itemID, err := uuid.Parse(c.Param("itemID"))
+	if err != nil {
+		respondInvalid(c, "itemID", "must be a UUID")
+		return
+	}
+
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.pu.DeleteListItem(c, userID, c.Param("list"), itemID, expectedVersion)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondProfile(c, http.StatusOK, "List item deleted successfully", profile)
+}
+
+// ReorderListItems は一覧の項目を指定されたIDの順に並べ替えます
+func (h *profileHandler) ReorderListItems(c *gin.Context) {
+	userID, ok := bindProfileUserID(c)
+	if !ok {
+		return
+	}
+
+	var req entity.ReorderListItemsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.pu.ReorderListItems(c, userID, c.Param("list"), req.ItemIDs, expectedVersion)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondProfile(c, http.StatusOK, "List items reordered successfully", profile)
+}
+
+// bindProfileUserID はURLパラメータのユーザーIDを解析し、不正な場合・アクセスできない場合はエラーを返します
+func bindProfileUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(userIDParam(c))
+	if err != nil || userID == uuid.Nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return uuid.Nil, false
+	}
+	if !authorizeUser(c, userID) {
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// respondProfile は保存後のプロフィールをETagと共に返します（UTF-8で明示的に設定）
+func respondProfile(c *gin.Context, statusCode int, message string, profile *entity.Profile) {
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	setETag(c, profile)
+	c.JSON(statusCode, gin.H{
+		"message": message,
+		"profile": profile,
+	})
+}
+
 // validateUTF8Encoding はプロフィールデータのUTF-8エンコーディングを検証します（文字化け対策）
 func (h *profileHandler) validateUTF8Encoding(data entity.ProfileData) error {
 	// 文字列フィールドのUTF-8検証
@@ -172,7 +346,7 @@ func (h *profileHandler) validateUTF8Encoding(data entity.ProfileData) error {
 
 	for _, field := range fields {
 		if !utf8.ValidString(field) {
-			return fmt.Errorf("invalid UTF-8 encoding detected in text field")
+			return fmt.Errorf("%w: invalid UTF-8 encoding detected in text field", entity.ErrValidation)
 		}
 	}
 
@@ -191,7 +365,7 @@ func (h *profileHandler) validateUTF8Encoding(data entity.ProfileData) error {
 	for _, slice := range sliceFields {
 		for _, item := range slice {
 			if !utf8.ValidString(item) {
-				return fmt.Errorf("invalid UTF-8 encoding detected in array field")
+				return fmt.Errorf("%w: invalid UTF-8 encoding detected in array field", entity.ErrValidation)
 			}
 		}
 	}
@@ -25,7 +25,7 @@ func (h *sampleUserHandler) GetAllSampleUsers(c *gin.Context) {
 	var users *[]entity.SampleUser
 	users, err := h.sur.GetAllSampleUsers(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, users)
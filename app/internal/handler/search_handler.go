@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// SearchHandler ESと全サービスのプロフィールを横断した検索のHTTPハンドラー
+type SearchHandler interface {
+	Search(c *gin.Context)
+}
+
+type searchHandler struct {
+	su usecase.SearchUsecase
+}
+
+func NewSearchHandler(u usecase.SearchUsecase) SearchHandler {
+	return &searchHandler{su: u}
+}
+
+// Search GET /api/user/:userID/search?q=キーワード&limit=件数
+func (h *searchHandler) Search(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	limit := 0
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			respondInvalid(c, "limit", "must be a positive integer")
+			return
+		}
+	}
+
+	response, err := h.su.Search(c, userID, c.Query("q"), limit)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
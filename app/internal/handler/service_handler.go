@@ -0,0 +1,324 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// ServiceHandler 就活サービスのHTTPハンドラー
+// サービスキーごとにgin.HandlerFuncを生成し、ルーターからレジストリ順に登録する
+type ServiceHandler interface {
+	GetByID(serviceKey string) gin.HandlerFunc
+	CreateOrUpdate(serviceKey string) gin.HandlerFunc
+	Put(serviceKey string) gin.HandlerFunc
+	Patch(serviceKey string) gin.HandlerFunc
+	AddListItem(serviceKey string) gin.HandlerFunc
+	UpdateListItem(serviceKey string) gin.HandlerFunc
+	DeleteListItem(serviceKey string) gin.HandlerFunc
+	ReorderListItems(serviceKey string) gin.HandlerFunc
+	GetAllServiceFields(c *gin.Context)
+	GetServiceFields(c *gin.Context)
+}
+
+type serviceHandler struct {
+	sus map[string]usecase.ServiceUsecase
+}
+
+func NewServiceHandler(sus map[string]usecase.ServiceUsecase) ServiceHandler {
+	return &serviceHandler{sus: sus}
+}
+
+func (h *serviceHandler) GetByID(serviceKey string) gin.HandlerFunc {
+	su := h.sus[serviceKey]
+	return func(c *gin.Context) {
+		// URLパラメータからIDを取得
+		idParam := userIDParam(c)
+		if idParam == "" {
+			respondInvalid(c, "id", "is required")
+			return
+		}
+
+		// UUIDのパース
+		userID, err := uuid.Parse(idParam)
+		if err != nil {
+			respondInvalid(c, "user_id", "must be a UUID")
+			return
+		}
+		if !authorizeUser(c, userID) {
+			return
+		}
+
+		model, err := su.GetByUserID(c, userID)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		if model == nil {
+			respondError(c, entity.NewKindError(entity.ErrNotFound, su.Definition().ModelName()+" record not found"))
+			return
+		}
+
+		setETag(c, model)
+		c.JSON(http.StatusOK, model)
+	}
+}
+
+// CreateOrUpdate 旧ルート（ユーザーIDとサービスデータをリクエストボディで指定）
+func (h *serviceHandler) CreateOrUpdate(serviceKey string) gin.HandlerFunc {
+	save := h.save(serviceKey)
+	return func(c *gin.Context) {
+		var req entity.CreateServiceRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		// UUIDのパース
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			respondInvalid(c, "user_id", "must be a UUID")
+			return
+		}
+		if !authorizeUser(c, userID) {
+			return
+		}
+
+		// Usecaseに渡すのはdataの部分のみ
+		save(c, userID, req.Data)
+	}
+}
+
+// Put サービスデータを作成・置き換え（/api/v1、ユーザーIDはパスで指定し、リクエストボディはサービスデータそのもの）
+func (h *serviceHandler) Put(serviceKey string) gin.HandlerFunc {
+	save := h.save(serviceKey)
+	return func(c *gin.Context) {
+		userID, ok := bindServiceUserID(c)
+		if !ok {
+			return
+		}
+
+		var data json.RawMessage
+		if !bindJSON(c, &data) {
+			return
+		}
+		save(c, userID, data)
+	}
+}
+
+// save サービスデータを保存してレスポンスを返す（旧ルートと/api/v1で共通）
+func (h *serviceHandler) save(serviceKey string) func(c *gin.Context, userID uuid.UUID, data json.RawMessage) {
+	su := h.sus[serviceKey]
+	return func(c *gin.Context, userID uuid.UUID, data json.RawMessage) {
+		expectedVersion, ok := bindIfMatch(c)
+		if !ok {
+			return
+		}
+
+		model, err := su.CreateOrUpdate(c, userID, data, expectedVersion)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		setETag(c, model)
+		c.JSON(http.StatusOK, model)
+	}
+}
+
+// Patch JSON Merge Patchで部分更新（キーがない項目はそのまま、nullの項目はクリア）
+func (h *serviceHandler) Patch(serviceKey string) gin.HandlerFunc {
+	su := h.sus[serviceKey]
+	return func(c *gin.Context) {
+		userID, ok := bindServiceUserID(c)
+		if !ok {
+			return
+		}
+
+		patch, ok := bindMergePatch(c)
+		if !ok {
+			return
+		}
+
+		expectedVersion, ok := bindIfMatch(c)
+		if !ok {
+			return
+		}
+
+		model, err := su.Patch(c, userID, patch, expectedVersion)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		setETag(c, model)
+		c.JSON(http.StatusOK, model)
+	}
+}
+
+// AddListItem 一覧に項目を追加（スキルとその説明などをまとめて追加）
+func (h *serviceHandler) AddListItem(serviceKey string) gin.HandlerFunc {
+	su := h.sus[serviceKey]
+	return func(c *gin.Context) {
+		userID, ok := bindServiceUserID(c)
+		if !ok {
+			return
+		}
+
+		var req entity.ListItemRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		expectedVersion, ok := bindIfMatch(c)
+		if !ok {
+			return
+		}
+
+		model, err := su.AddListItem(c, userID, c.Param("list"), req.Values, req.Position, expectedVersion)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		setETag(c, model)
+		c.JSON(http.StatusCreated, model)
+	}
+}
+
+// UpdateListItem 一覧の項目の値を更新
+func (h *serviceHandler) UpdateListItem(serviceKey string) gin.HandlerFunc {
+	su := h.sus[serviceKey]
+	return func(c *gin.Context) {
+		userID, ok := bindServiceUserID(c)
+		if !ok {
+			return
+		}
+
+		itemID, err := uuid.Parse(c.Param("itemID"))
+		if err != nil {
+			respondInvalid(c, "itemID", "must be a UUID")
+			return
+		}
+
+		var req entity.ListItemRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		expectedVersion, ok := bindIfMatch(c)
+		if !ok {
+			return
+		}
+
+		model, err := su.UpdateListItem(c, userID, c.Param("list"), itemID, req.Values, expectedVersion)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		setETag(c, model)
+		c.JSON(http.StatusOK, model)
+	}
+}
+
+// DeleteListItem 一覧から項目を削除（対になる説明なども一緒に削除）
+func (h *serviceHandler) DeleteListItem(serviceKey string) gin.HandlerFunc {
+	su := h.sus[serviceKey]
+	return func(c *gin.Context) {
+		userID, ok := bindServiceUserID(c)
+		if !ok {
+			return
+		}
+
+		itemID, err := uuid.Parse(c.Param("itemID"))
+		if err != nil {
+			respondInvalid(c, "itemID", "must be a UUID")
+			return
+		}
+
+		expectedVersion, ok := bindIfMatch(c)
+		if !ok {
+			return
+		}
+
+		model, err := su.DeleteListItem(c, userID, c.Param("list"), itemID, expectedVersion)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		setETag(c, model)
+		c.JSON(http.StatusOK, model)
+	}
+}
+
+// ReorderListItems 一覧の項目を並べ替え
+func (h *serviceHandler) ReorderListItems(serviceKey string) gin.HandlerFunc {
+	su := h.sus[serviceKey]
+	return func(c *gin.Context) {
+		userID, ok := bindServiceUserID(c)
+		if !ok {
+			return
+		}
+
+		var req entity.ReorderListItemsRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+
+		expectedVersion, ok := bindIfMatch(c)
+		if !ok {
+			return
+		}
+
+		model, err := su.ReorderListItems(c, userID, c.Param("list"), req.ItemIDs, expectedVersion)
+		if err != nil {
+			respondError(c, err)
+			return
+		}
+
+		setETag(c, model)
+		c.JSON(http.StatusOK, model)
+	}
+}
+
+// URLパラメータのユーザーIDを解析し、不正な場合は400を返す
+func bindServiceUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(userIDParam(c))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return uuid.Nil, false
+	}
+	if !authorizeUser(c, userID) {
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// GetAllServiceFields 全サービスの項目定義を取得（フロントエンドのフォーム生成用）
+func (h *serviceHandler) GetAllServiceFields(c *gin.Context) {
+	services := make([]entity.ServiceMetadata, 0, len(entity.Services))
+	for _, def := range entity.Services {
+		services = append(services, def.Metadata())
+	}
+
+	c.JSON(http.StatusOK, gin.H{"services": services})
+}
+
+// GetServiceFields 指定されたサービスの項目定義を取得（英語名・日本語名どちらでも可）
+func (h *serviceHandler) GetServiceFields(c *gin.Context) {
+	def, exists := entity.ResolveService(c.Param("service"))
+	if !exists {
+		respondError(c, entity.NewKindError(entity.ErrNotFound, "service not found (valid: "+strings.Join(entity.ServiceKeys(), ", ")+")"))
+		return
+	}
+
+	c.JSON(http.StatusOK, def.Metadata())
+}
@@ -12,32 +12,30 @@ import (
 
 type UserHandler interface {
 	UpdateUserServices(c *gin.Context)
+	PutUserServices(c *gin.Context)
 	CreateUser(c *gin.Context)
 	UpdateUser(c *gin.Context)
+	PutUser(c *gin.Context)
+	PatchUser(c *gin.Context)
 	GetUserByID(c *gin.Context)
 	GetUserServices(c *gin.Context)
 	GetUserServiceDetails(c *gin.Context)
+	DeleteUser(c *gin.Context)
 }
 
 type userHandler struct {
 	uu  usecase.UserUsecase
-	su  usecase.SupporterzUsecase
-	csu usecase.CareerSelectUsecase
-	lru usecase.LevtechRookieUsecase
-	mu  usecase.MynaviUsecase
-	ocu usecase.OneCareerUsecase
+	sus map[string]usecase.ServiceUsecase
 	pu  usecase.ProfileUsecase
+	csu usecase.CustomServiceUsecase
 }
 
-func NewUserHandler(u usecase.UserUsecase, su usecase.SupporterzUsecase, csu usecase.CareerSelectUsecase, lru usecase.LevtechRookieUsecase, mu usecase.MynaviUsecase, ocu usecase.OneCareerUsecase, pu usecase.ProfileUsecase) UserHandler {
+func NewUserHandler(u usecase.UserUsecase, sus map[string]usecase.ServiceUsecase, pu usecase.ProfileUsecase, csu usecase.CustomServiceUsecase) UserHandler {
 	return &userHandler{
 		uu:  u,
-		su:  su,
-		csu: csu,
-		lru: lru,
-		mu:  mu,
-		ocu: ocu,
+		sus: sus,
 		pu:  pu,
+		csu: csu,
 	}
 }
 
@@ -46,84 +44,187 @@ type updateServicesRequest struct {
 	Services []string `json:"services"`
 }
 
+// UpdateUserServices 旧ルート（ユーザーIDをリクエストボディで指定）
 func (h *userHandler) UpdateUserServices(c *gin.Context) {
 	var req updateServicesRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !bindJSON(c, &req) {
+		return
+	}
+	if !authorizeUserString(c, req.UserID) {
 		return
 	}
+	h.updateUserServices(c, req.UserID, req.Services)
+}
 
-	if err := h.uu.UpdateUserServices(c, req.UserID, req.Services); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+// PutUserServices 利用中の就活サービスを置き換え（/api/v1、ユーザーIDはパスで指定）
+func (h *userHandler) PutUserServices(c *gin.Context) {
+	userID := c.Param("userID")
+	if !authorizeUserString(c, userID) {
 		return
 	}
+	var req entity.UserServicesRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+	h.updateUserServices(c, userID, req.Services)
+}
+
+func (h *userHandler) updateUserServices(c *gin.Context, userID string, services []string) {
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.uu.UpdateUserServices(c, userID, services, expectedVersion)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	setETag(c, user)
 	c.JSON(http.StatusOK, gin.H{"message": "Services updated successfully"})
 }
 
 // 新しいユーザーを作成するAPIの実装
 func (h *userHandler) CreateUser(c *gin.Context) {
 	var req entity.CreateUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	// UUIDのパース
 	userID, err := uuid.Parse(req.UserID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
 		return
 	}
 
 	// Usecaseに渡すのはuser_idとdataの部分
-	user, err := h.uu.CreateUser(c, userID, req.Data)
+	user, err := h.uu.CreateUser(c, userID, req.Data, expectedVersion)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, user)
 	c.JSON(http.StatusOK, user)
 }
 
+// UpdateUser 旧ルート（ユーザーIDをリクエストボディで指定）
 func (h *userHandler) UpdateUser(c *gin.Context) {
 	var req entity.UpdateUserRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !bindJSON(c, &req) {
 		return
 	}
 
 	// UUIDのパース
 	userID, err := uuid.Parse(req.UserID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
 		return
 	}
 
 	// Usecaseに渡すのはdataの部分のみ
-	user, err := h.uu.UpdateUser(c, userID, req.Data)
+	h.updateUser(c, userID, req.Data)
+}
+
+// PutUser ユーザー情報を更新（/api/v1、ユーザーIDはパスで指定し、リクエストボディはdataの部分のみ）
+func (h *userHandler) PutUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	var data entity.UserData
+	if !bindJSON(c, &data) {
+		return
+	}
+	h.updateUser(c, userID, data)
+}
+
+func (h *userHandler) updateUser(c *gin.Context, userID uuid.UUID, data entity.UserData) {
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.uu.UpdateUser(c, userID, data, expectedVersion)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
+	setETag(c, user)
+	c.JSON(http.StatusOK, user)
+}
+
+// PatchUser JSON Merge Patchでユーザー情報を部分更新（キーがない項目はそのまま、nullの項目はクリア）
+func (h *userHandler) PatchUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	patch, ok := bindMergePatch(c)
+	if !ok {
+		return
+	}
+
+	expectedVersion, ok := bindIfMatch(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.uu.PatchUser(c, userID, patch, expectedVersion)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	setETag(c, user)
 	c.JSON(http.StatusOK, user)
 }
 
 func (h *userHandler) GetUserByID(c *gin.Context) {
 	userID := c.Param("userID")
+	if !authorizeUserString(c, userID) {
+		return
+	}
 	user, err := h.uu.GetUserByID(c, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
+	setETag(c, user)
 	c.JSON(http.StatusOK, user)
 }
 
 func (h *userHandler) GetUserServices(c *gin.Context) {
 	userID := c.Param("userID")
+	if !authorizeUserString(c, userID) {
+		return
+	}
 	services, err := h.uu.GetUserServices(c, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"services": services})
@@ -135,14 +236,17 @@ func (h *userHandler) GetUserServiceDetails(c *gin.Context) {
 	// UUIDに変換
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userUUID) {
 		return
 	}
 
 	// ユーザーのサービス一覧を取得
 	services, err := h.uu.GetUserServices(c, userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		respondError(c, err)
 		return
 	}
 
@@ -150,49 +254,31 @@ func (h *userHandler) GetUserServiceDetails(c *gin.Context) {
 
 	// 各サービスの詳細データを取得
 	for _, serviceName := range services {
-		switch serviceName {
-		case "サポーターズ":
-			data, err := h.su.GetSupporterzByUserID(c, userUUID)
-			if err == nil {
-				serviceData["supporterz"] = data
-			} else {
-				serviceData["supporterz"] = gin.H{"error": err.Error()}
-			}
-
-		case "マイナビ":
-			data, err := h.mu.GetMynaviByUserID(c, userUUID)
-			if err == nil {
-				serviceData["mynavi"] = data
-			} else {
-				serviceData["mynavi"] = gin.H{"error": err.Error()}
-			}
-
-		case "レバテックルーキー":
-			data, err := h.lru.GetLevtechRookieByUserID(c, userUUID)
-			if err == nil {
-				serviceData["levtech_rookie"] = data
-			} else {
-				serviceData["levtech_rookie"] = gin.H{"error": err.Error()}
-			}
-
-		case "ワンキャリア":
-			data, err := h.ocu.GetOneCareerByUserID(c, userUUID)
-			if err == nil {
-				serviceData["one_career"] = data
-			} else {
-				serviceData["one_career"] = gin.H{"error": err.Error()}
-			}
-
-		case "キャリアセレクト":
-			data, err := h.csu.GetCareerSelectByUserID(c, userUUID)
-			if err == nil {
-				serviceData["career_select"] = data
-			} else {
-				serviceData["career_select"] = gin.H{"error": err.Error()}
-			}
+		def, exists := entity.FindServiceByDisplayName(serviceName)
+		if !exists {
+			continue
+		}
+		su, exists := h.sus[def.Key]
+		if !exists {
+			continue
+		}
+
+		data, err := su.GetByUserID(c, userUUID)
+		if err == nil {
+			serviceData[def.Key] = data
+		} else {
+			serviceData[def.Key] = gin.H{"error": err.Error()}
 		}
 	}
 
+	// ユーザー定義のカスタムサービスを取得
+	customServices, err := h.csu.GetCustomServicesByUserID(c, userUUID)
+	if err == nil {
+		serviceData["custom_services"] = customServices
+	} else {
+		serviceData["custom_services"] = gin.H{"error": err.Error()}
+	}
+
 	// プロフィール情報を取得
 	profile, err := h.pu.GetProfileByUserID(c, userUUID)
 	if err != nil {
@@ -207,3 +293,22 @@ func (h *userHandler) GetUserServiceDetails(c *gin.Context) {
 		"data":     serviceData,
 	})
 }
+
+func (h *userHandler) DeleteUser(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("userID"))
+	if err != nil {
+		respondInvalid(c, "user_id", "must be a UUID")
+		return
+	}
+	if !authorizeUser(c, userID) {
+		return
+	}
+
+	receipt, err := h.uu.DeleteUser(c, userID)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, receipt)
+}
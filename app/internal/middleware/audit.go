@@ -0,0 +1,74 @@
+// Package middleware ルーターに適用するミドルウェア
+package middleware
+
+import (
+	"context"
+	"log"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/usecase"
+)
+
+// RequestIDHeader リクエストIDのヘッダー（クライアントの指定がない場合は生成してレスポンスに返す）
+const RequestIDHeader = "X-Request-ID"
+
+const (
+	maxRequestIDLength = 100
+	maxUserAgentLength = 500
+)
+
+// Audit リクエストIDを払い出し、リクエスト中の変更を監査イベントとして記録する
+// 変更はユースケースが保存のコミット後に監査情報へ追加するため、変更のないリクエストは記録しない
+func Audit(u usecase.AuditUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		audit := &entity.AuditContext{
+			RequestID: requestID,
+			Method:    c.Request.Method,
+			Endpoint:  c.FullPath(),
+			Path:      c.Request.URL.Path,
+			ClientIP:  c.ClientIP(),
+			UserAgent: truncateRunes(c.Request.UserAgent(), maxUserAgentLength),
+		}
+		// gin.Contextとc.Request.Context()のどちらを渡すユースケースからも参照できるようにする
+		c.Set(entity.AuditContextKey, audit)
+		c.Request = c.Request.WithContext(entity.WithAuditContext(c.Request.Context(), audit))
+
+		c.Next()
+
+		// クライアントが切断しても記録するため、リクエストのキャンセルを引き継がない
+		ctx := context.WithoutCancel(c.Request.Context())
+		if err := u.RecordRequest(ctx, audit, c.Writer.Status()); err != nil {
+			log.Printf("Failed to record audit event (request %s): %v", requestID, err)
+		}
+	}
+}
+
+// validRequestID クライアントが指定したリクエストIDを使えるか（長さと表示可能なASCII文字のみ）
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func truncateRunes(s string, maxLength int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLength {
+		return s
+	}
+	return string(runes[:maxLength])
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/infrastructure/auth"
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// Auth Authorizationヘッダーのアクセストークンを検証し、認証されたユーザーをコンテキストに設定する
+// データの所有者の確認はハンドラー・ユースケースが行う。verifierがnilの場合（AUTH_DISABLED=true）は検証しない
+func Auth(verifier *auth.Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if verifier == nil || c.Request.Method == http.MethodOptions {
+			c.Next()
+			return
+		}
+
+		token, ok := bearerToken(c.GetHeader("Authorization"))
+		if !ok {
+			abortUnauthorized(c, "missing bearer token")
+			return
+		}
+		principal, err := verifier.Verify(token)
+		if err != nil {
+			abortUnauthorized(c, err.Error())
+			return
+		}
+
+		// gin.Contextとc.Request.Context()のどちらを渡すユースケースからも参照できるようにする
+		c.Set(entity.PrincipalContextKey, principal)
+		c.Request = c.Request.WithContext(entity.WithPrincipal(c.Request.Context(), principal))
+
+		// 管理者による変更は監査イベントの実行者を管理者にする（本人の場合は既定のuser / ai）
+		if audit := entity.AuditContextFrom(c); audit != nil && principal.IsAdmin() {
+			userID := principal.UserID
+			audit.SetActor(entity.ActorTypeAdmin, &userID)
+		}
+
+		c.Next()
+	}
+}
+
+// bearerToken Authorizationヘッダーからトークンを取り出す
+func bearerToken(header string) (string, bool) {
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// abortUnauthorized 401のエラーレスポンス（middleware.Errorsが本文を返す）
+func abortUnauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+	_ = c.Error(fmt.Errorf("%w: %s", entity.ErrUnauthorized, message))
+	c.Abort()
+}
@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ginのパスパラメータ（:id）
+var pathParamPattern = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// Deprecated 旧ルートのレスポンスにDeprecationヘッダー（RFC 9745）と、後継のルートを示すLinkヘッダーを付ける
+// successorsのキーは"メソッド 旧ルートのパス"、値は"メソッド 後継のルートのパス"（ginの形式）
+// 後継のパスの:userIDなどは旧ルートの同名のパラメータで置き換え、旧ルートにない場合は{userID}のまま返す
+func Deprecated(since time.Time, successors map[string]string) gin.HandlerFunc {
+	deprecation := "@" + strconv.FormatInt(since.Unix(), 10)
+	return func(c *gin.Context) {
+		c.Header("Deprecation", deprecation)
+		if successor, exists := successors[c.Request.Method+" "+c.FullPath()]; exists {
+			_, path, _ := strings.Cut(successor, " ")
+			c.Header("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, expandPath(path, c.Params)))
+		}
+		c.Next()
+	}
+}
+
+// expandPath パスのパラメータをリクエストの値で置き換える
+func expandPath(path string, params gin.Params) string {
+	return pathParamPattern.ReplaceAllStringFunc(path, func(param string) string {
+		if value, exists := params.Get(param[1:]); exists {
+			return url.PathEscape(value)
+		}
+		return "{" + param[1:] + "}"
+	})
+}
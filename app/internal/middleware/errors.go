@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// エラーの種類ごとのステータスコードとcode（上から順に判定する）
+var errorMappings = []struct {
+	kind       error
+	statusCode int
+	code       string
+}{
+	{entity.ErrPreconditionFailed, http.StatusPreconditionFailed, entity.ErrorCodePreconditionFailed},
+	{entity.ErrConflict, http.StatusConflict, entity.ErrorCodeConflict},
+	{entity.ErrValidation, http.StatusBadRequest, entity.ErrorCodeValidation},
+	{entity.ErrUnauthorized, http.StatusUnauthorized, entity.ErrorCodeUnauthorized},
+	{entity.ErrForbidden, http.StatusForbidden, entity.ErrorCodeForbidden},
+	{entity.ErrNotFound, http.StatusNotFound, entity.ErrorCodeNotFound},
+	{entity.ErrPayloadTooLarge, http.StatusRequestEntityTooLarge, entity.ErrorCodePayloadTooLarge},
+	{entity.ErrUnsupportedMediaType, http.StatusUnsupportedMediaType, entity.ErrorCodeUnsupportedMedia},
+	{entity.ErrUpstream, http.StatusBadGateway, entity.ErrorCodeUpstream},
+}
+
+// Errors ハンドラー・ミドルウェアがc.Errorで設定したエラーを、共通のエラーレスポンスに変換する
+// 種類に当てはまらないエラー（DBのエラーなど）は500とし、詳細はログにのみ出力する
+func Errors() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		err := c.Errors.Last().Err
+		statusCode, body := errorBody(err)
+		body.RequestID = c.Writer.Header().Get(RequestIDHeader)
+		if statusCode >= http.StatusInternalServerError {
+			log.Printf("Request %s %s failed (request %s): %v", c.Request.Method, c.Request.URL.Path, body.RequestID, err)
+		}
+		c.JSON(statusCode, entity.ErrorResponse{Error: body})
+	}
+}
+
+// NoRoute 存在しないパスへのリクエストを404のエラーレスポンスにする
+func NoRoute(c *gin.Context) {
+	_ = c.Error(entity.NewKindError(entity.ErrNotFound, "route not found"))
+}
+
+// errorBody エラーの種類からステータスコードとレスポンスの本文を決める
+func errorBody(err error) (int, entity.ErrorBody) {
+	for _, mapping := range errorMappings {
+		if !errors.Is(err, mapping.kind) {
+			continue
+		}
+		body := entity.ErrorBody{Code: mapping.code, Message: err.Error()}
+		var validation *entity.ValidationError
+		if errors.As(err, &validation) {
+			body.Fields = validation.Fields
+		}
+		var conflict *entity.ConflictError
+		if errors.As(err, &conflict) {
+			body.Current = conflict.Current
+		}
+		var batch *entity.BatchError
+		if errors.As(err, &batch) {
+			body.Results = batch.Results
+			for i, result := range body.Results {
+				if result.Err != nil {
+					_, partBody := errorBody(result.Err)
+					body.Results[i].Error = &partBody
+				}
+			}
+		}
+		if mapping.kind == entity.ErrUpstream {
+			// 外部サービスのレスポンスをそのまま返さない
+			body.Message = entity.ErrUpstream.Error()
+		}
+		return mapping.statusCode, body
+	}
+	return http.StatusInternalServerError, entity.ErrorBody{
+		Code:    entity.ErrorCodeInternal,
+		Message: "internal server error",
+	}
+}
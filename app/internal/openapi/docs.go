@@ -0,0 +1,39 @@
+package openapi
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SwaggerUIVersion APIドキュメントの画面に使うSwagger UIのバージョン
+const SwaggerUIVersion = "5.17.14"
+
+const docsHTML = `<!DOCTYPE html>
+<html lang="ja">
+<head>
+  <meta charset="utf-8">
+  <title>%[1]s</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@%[2]s/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@%[2]s/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "%[3]s", dom_id: "#swagger-ui", persistAuthorization: true });
+    };
+  </script>
+</body>
+</html>
+`
+
+// DocsHandler ドキュメントを表示するSwagger UIの画面のハンドラー（specURLはドキュメントのJSONのパス）
+func DocsHandler(title, specURL string) gin.HandlerFunc {
+	body := fmt.Sprintf(docsHTML, html.EscapeString(title), SwaggerUIVersion, html.EscapeString(specURL))
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(body))
+	}
+}
@@ -0,0 +1,246 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// Version OpenAPIのバージョン
+const Version = "3.0.3"
+
+// Document OpenAPI 3のドキュメント（このAPIで使う範囲のみ）
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components Components            `json:"components"`
+	Security   []SecurityRequirement `json:"security,omitempty"`
+
+	generator  *Generator         // エンティティの構造体からスキーマを生成
+	pathParams map[string]*Schema // パスパラメータの名前ごとのスキーマ（未指定は文字列）
+}
+
+// Info APIの情報
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem パスごとの操作（キーは小文字のHTTPメソッド）
+type PathItem map[string]*Operation
+
+// Operation 1つのエンドポイント
+type Operation struct {
+	Tags        []string               `json:"tags,omitempty"`
+	Summary     string                 `json:"summary,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	OperationID string                 `json:"operationId,omitempty"`
+	Parameters  []*Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody           `json:"requestBody,omitempty"`
+	Responses   map[string]*Response   `json:"responses"`
+	Deprecated  bool                   `json:"deprecated,omitempty"`
+	Security    *[]SecurityRequirement `json:"security,omitempty"` // 認証不要の場合は空の配列
+}
+
+// Parameter パス・クエリ・ヘッダーのパラメータ
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"` // path / query / header
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody リクエストボディ
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response レスポンス
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType Content-Typeごとの本文のスキーマ
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components 共通のスキーマと認証方式
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme 認証方式
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// SecurityRequirement 操作に必要な認証方式
+type SecurityRequirement map[string][]string
+
+// BearerAuth アクセストークン（JWT）による認証の名前
+const BearerAuth = "bearerAuth"
+
+// Content-Type
+const (
+	ContentTypeJSON       = "application/json"
+	ContentTypeMergePatch = "application/merge-patch+json"
+	ContentTypeMultipart  = "multipart/form-data"
+)
+
+// New ドキュメントを生成（全操作にアクセストークンが必要とし、不要な操作はPublicで指定する）
+func New(info Info) *Document {
+	d := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: map[string]*Schema{},
+			SecuritySchemes: map[string]SecurityScheme{
+				BearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+		Security:   []SecurityRequirement{{BearerAuth: {}}},
+		pathParams: map[string]*Schema{},
+	}
+	d.generator = NewGenerator(d.Components.Schemas)
+	return d
+}
+
+// Generator エンティティのスキーマの生成（型ごとのスキーマの上書きに使う）
+func (d *Document) Generator() *Generator {
+	return d.generator
+}
+
+// Schema 値の型のスキーマ（名前のある構造体はcomponentsに登録して参照を返す）
+func (d *Document) Schema(v interface{}) *Schema {
+	return d.generator.Schema(v)
+}
+
+// PathParam パスパラメータのスキーマを名前ごとに指定（Addで自動的に追加される）
+func (d *Document) PathParam(name string, schema *Schema) {
+	d.pathParams[name] = schema
+}
+
+// ginのパスパラメータ（:id）
+var ginParamPattern = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// Add 操作を追加（pathはginの形式の/users/:userIDで指定する）
+// パスパラメータと共通のエラーレスポンスは自動的に追加する
+func (d *Document) Add(method, path string, op *Operation) {
+	var params []*Parameter
+	for _, match := range ginParamPattern.FindAllStringSubmatch(path, -1) {
+		schema, exists := d.pathParams[match[1]]
+		if !exists {
+			schema = &Schema{Type: "string"}
+		}
+		params = append(params, &Parameter{Name: match[1], In: "path", Required: true, Schema: schema})
+	}
+	op.Parameters = append(params, op.Parameters...)
+	if op.Responses == nil {
+		op.Responses = map[string]*Response{}
+	}
+	if _, exists := op.Responses["default"]; !exists {
+		op.Responses["default"] = &Response{
+			Description: "エラー（codeでエラーの種類を判定する）",
+			Content:     JSONContent(d.Schema(entity.ErrorResponse{})),
+		}
+	}
+
+	specPath := OpenAPIPath(path)
+	if d.Paths[specPath] == nil {
+		d.Paths[specPath] = PathItem{}
+	}
+	d.Paths[specPath][strings.ToLower(method)] = op
+}
+
+// Operation 追加済みの操作（pathはginの形式、存在しない場合はnil）
+func (d *Document) Operation(method, path string) *Operation {
+	return d.Paths[OpenAPIPath(path)][strings.ToLower(method)]
+}
+
+// OpenAPIPath ginのパス（/users/:userID）をOpenAPIの形式（/users/{userID}）に変換
+func OpenAPIPath(path string) string {
+	return ginParamPattern.ReplaceAllString(path, "{$1}")
+}
+
+// Handler ドキュメントをJSONで返すハンドラー（全操作を追加してから呼ぶこと）
+func (d *Document) Handler() gin.HandlerFunc {
+	body, err := json.Marshal(d)
+	return func(c *gin.Context) {
+		if err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+	}
+}
+
+// Public 認証不要の操作に指定するセキュリティ要件
+func Public() *[]SecurityRequirement {
+	return &[]SecurityRequirement{}
+}
+
+// JSONContent JSONの本文
+func JSONContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{ContentTypeJSON: {Schema: schema}}
+}
+
+// JSONBody 必須のJSONのリクエストボディ
+func JSONBody(schema *Schema) *RequestBody {
+	return &RequestBody{Required: true, Content: JSONContent(schema)}
+}
+
+// MergePatchBody JSON Merge Patch（application/jsonも可）のリクエストボディ
+func MergePatchBody(schema *Schema) *RequestBody {
+	return &RequestBody{Required: true, Content: map[string]MediaType{
+		ContentTypeMergePatch: {Schema: schema},
+		ContentTypeJSON:       {Schema: schema},
+	}}
+}
+
+// FileBody 1つのファイルをアップロードするmultipart/form-dataのリクエストボディ
+func FileBody(field string) *RequestBody {
+	return &RequestBody{Required: true, Content: map[string]MediaType{
+		ContentTypeMultipart: {Schema: &Schema{
+			Type:       "object",
+			Properties: map[string]*Schema{field: {Type: "string", Format: "binary"}},
+			Required:   []string{field},
+		}},
+	}}
+}
+
+// JSONResponse JSONのレスポンス
+func JSONResponse(description string, schema *Schema) *Response {
+	return &Response{Description: description, Content: JSONContent(schema)}
+}
+
+// BinaryResponse ファイルのレスポンス
+func BinaryResponse(description, contentType string) *Response {
+	return &Response{Description: description, Content: map[string]MediaType{
+		contentType: {Schema: &Schema{Type: "string", Format: "binary"}},
+	}}
+}
+
+// Query クエリパラメータ
+func Query(name, description string, schema *Schema) *Parameter {
+	return &Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+// Header ヘッダーのパラメータ
+func Header(name, description string) *Parameter {
+	return &Parameter{Name: name, In: "header", Description: description, Schema: &Schema{Type: "string"}}
+}
@@ -0,0 +1,242 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Schema JSONのスキーマ（OpenAPI 3.0のSchema Objectのうち、このAPIで使う範囲のみ）
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+}
+
+// Object プロパティを指定したオブジェクト（gin.Hで返すレスポンスなど、すべてのプロパティを必須とする）
+func Object(properties map[string]*Schema) *Schema {
+	required := make([]string, 0, len(properties))
+	for name := range properties {
+		required = append(required, name)
+	}
+	sort.Strings(required)
+	return &Schema{Type: "object", Properties: properties, Required: required}
+}
+
+// ArrayOf 配列
+func ArrayOf(items *Schema) *Schema {
+	return &Schema{Type: "array", Items: items}
+}
+
+// MapOf キーが任意の文字列のオブジェクト
+func MapOf(values *Schema) *Schema {
+	return &Schema{Type: "object", AdditionalProperties: values}
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	uuidType      = reflect.TypeOf(uuid.UUID{})
+	rawJSONType   = reflect.TypeOf(json.RawMessage{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// Generator Goの型（エンティティの構造体）からスキーマを生成
+// jsonタグをプロパティ名、bindingタグのrequiredを必須、labelタグを説明、gormタグのsizeを最大文字数とする
+type Generator struct {
+	schemas   map[string]*Schema // components.schemasに登録する名前のある構造体
+	names     map[reflect.Type]string
+	overrides map[reflect.Type]*Schema
+}
+
+// NewGenerator 名前のある構造体をschemasに登録するGeneratorを生成
+func NewGenerator(schemas map[string]*Schema) *Generator {
+	return &Generator{
+		schemas:   schemas,
+		names:     map[reflect.Type]string{},
+		overrides: map[reflect.Type]*Schema{},
+	}
+}
+
+// Override 型のスキーマを指定（独自のJSON形式で出力する型など、構造体から生成できない型に使う）
+func (g *Generator) Override(v interface{}, schema *Schema) {
+	g.overrides[reflect.TypeOf(v)] = schema
+}
+
+// Schema 値の型のスキーマ（名前のある構造体はcomponentsに登録して参照を返す）
+func (g *Generator) Schema(v interface{}) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	return g.schemaOf(reflect.TypeOf(v))
+}
+
+func (g *Generator) schemaOf(t reflect.Type) *Schema {
+	if schema, exists := g.overrides[t]; exists {
+		return schema
+	}
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	case rawJSONType:
+		return &Schema{}
+	}
+	if t.Kind() != reflect.Ptr && (t.Implements(marshalerType) || reflect.PointerTo(t).Implements(marshalerType)) {
+		return &Schema{} // 独自のJSON形式で出力する型はOverrideで指定する
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := g.schemaOf(t.Elem())
+		if schema.Ref != "" {
+			return schema // OpenAPI 3.0では参照にnullableを指定できない
+		}
+		nullable := *schema
+		nullable.Nullable = true
+		return &nullable
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return ArrayOf(g.schemaOf(t.Elem()))
+	case reflect.Map:
+		return MapOf(g.schemaOf(t.Elem()))
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		return g.ref(t)
+	default:
+		return &Schema{} // interface{}など任意の値
+	}
+}
+
+// ref 名前のある構造体をcomponentsに登録して参照を返す
+func (g *Generator) ref(t reflect.Type) *Schema {
+	name, exists := g.names[t]
+	if !exists {
+		name = t.Name()
+		if _, taken := g.schemas[name]; taken {
+			// 別のパッケージの同名の型はパッケージ名を付けて区別する
+			name = t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:] + "." + name
+		}
+		g.names[t] = name
+		g.schemas[name] = &Schema{} // 自己参照する構造体のために先に登録する
+		*g.schemas[name] = *g.structSchema(t)
+	}
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// structSchema 構造体のフィールドからオブジェクトのスキーマを生成
+func (g *Generator) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	g.addFields(schema, t)
+	sort.Strings(schema.Required)
+	return schema
+}
+
+func (g *Generator) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		// 埋め込みの構造体はフィールドを展開する（encoding/jsonと同じ）
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				g.addFields(schema, embedded)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		property := g.schemaOf(field.Type)
+		if property.Ref == "" {
+			copied := *property
+			property = &copied
+			property.Description = field.Tag.Get("label")
+			applyConstraints(property, field)
+		}
+		schema.Properties[name] = property
+		if hasRule(field.Tag.Get("binding"), "required") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// applyConstraints gormタグのsize（最大文字数）とbindingタグのmin・max（数値の範囲）を反映
+func applyConstraints(schema *Schema, field reflect.StructField) {
+	if schema.Type == "string" {
+		for _, option := range strings.Split(field.Tag.Get("gorm"), ";") {
+			if value, found := strings.CutPrefix(option, "size:"); found {
+				if size, err := strconv.Atoi(value); err == nil {
+					schema.MaxLength = &size
+				}
+			}
+		}
+	}
+	if schema.Type == "integer" || schema.Type == "number" {
+		for _, rule := range strings.Split(field.Tag.Get("binding"), ",") {
+			key, value, found := strings.Cut(rule, "=")
+			if !found {
+				continue
+			}
+			n, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "min":
+				schema.Minimum = &n
+			case "max":
+				schema.Maximum = &n
+			}
+		}
+	}
+}
+
+// hasRule bindingタグにルールが含まれるか
+func hasRule(binding, rule string) bool {
+	for _, r := range strings.Split(binding, ",") {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyRoutes ginに登録されたルートとドキュメントの操作が一致するか確認
+// ドキュメントに記載されていないルートと、存在しないルートの操作をエラーにする
+func (d *Document) VerifyRoutes(routes gin.RoutesInfo) error {
+	registered := make(map[string]bool, len(routes))
+	var missing []string
+	for _, route := range routes {
+		key := route.Method + " " + OpenAPIPath(route.Path)
+		registered[key] = true
+		if _, exists := d.Paths[OpenAPIPath(route.Path)][strings.ToLower(route.Method)]; !exists {
+			missing = append(missing, key)
+		}
+	}
+
+	var stale []string
+	for path, item := range d.Paths {
+		for method := range item {
+			key := strings.ToUpper(method) + " " + path
+			if !registered[key] {
+				stale = append(stale, key)
+			}
+		}
+	}
+
+	if len(missing) == 0 && len(stale) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+	var problems []string
+	if len(missing) > 0 {
+		problems = append(problems, "routes missing from the OpenAPI document: "+strings.Join(missing, ", "))
+	}
+	if len(stale) > 0 {
+		problems = append(problems, "documented operations without a route: "+strings.Join(stale, ", "))
+	}
+	return fmt.Errorf("openapi: %s", strings.Join(problems, "; "))
+}
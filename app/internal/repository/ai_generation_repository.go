@@ -2,43 +2,23 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	"github.com/google/uuid"
-	"github.com/lib/pq"
 	"gorm.io/gorm"
 
 	"job-hunting-service-management-backend/app/internal/entity"
 )
 
-// ヘルパー関数: string値の取得とマッピング
-func mapStringField(data map[string]interface{}, key string, target *string) {
-	if val, ok := data[key].(string); ok {
-		*target = val
-	}
-}
-
-// ヘルパー関数: pq.StringArray型の取得とマッピング
-func mapPQStringArrayField(data map[string]interface{}, key string, target *pq.StringArray) {
-	if val, ok := data[key].([]interface{}); ok {
-		result := make([]string, 0, len(val))
-		for _, v := range val {
-			if str, ok := v.(string); ok {
-				result = append(result, str)
-			}
-		}
-		*target = result
-	}
-}
-
 type AIGenerationRepository interface {
 	GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error)
-	SaveSupporterzData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error
-	SaveCareerSelectData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error
-	SaveOneCareerData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error
-	SaveMynaviData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error
-	SaveLevtechRookieData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error
+	GetProfileByUserID(ctx context.Context, userID uuid.UUID) (*entity.Profile, error)
+	// 生成開始時点のサービスデータのバージョンを取得（レコードが存在しない場合は0）
+	GetServiceVersion(ctx context.Context, def entity.ServiceDefinition, userID uuid.UUID) (int64, error)
+	// レコードが存在しない場合はnilを返す
+	GetCustomServiceByID(ctx context.Context, id uuid.UUID) (*entity.CustomService, error)
+	SaveGenerationHistory(ctx context.Context, history *entity.AIGenerationHistory) error
 }
 
 type aiGenerationRepository struct {
@@ -49,30 +29,6 @@ func NewAIGenerationRepository(db *gorm.DB) AIGenerationRepository {
 	return &aiGenerationRepository{db: db}
 }
 
-// logsテーブルのupdated_atを更新するヘルパーメソッド
-func (r *aiGenerationRepository) updateLogTimestamp(tx *gorm.DB, userID uuid.UUID, targetTable string, fieldNames []string) error {
-	now := time.Now()
-
-	// 各フィールドごとにログエントリを作成
-	for _, fieldName := range fieldNames {
-		log := &entity.Log{
-			ID:          uuid.New(),
-			UserID:      userID,
-			TargetTable: targetTable,
-			FieldName:   fieldName,
-			UpdatedAt:   now,
-		}
-
-		// 既存のレコードを更新するか、新しいレコードを作成
-		if err := tx.Where("user_id = ? AND target_table = ? AND field_name = ?", userID, targetTable, fieldName).
-			Assign(entity.Log{UpdatedAt: now}).
-			FirstOrCreate(log).Error; err != nil {
-			return fmt.Errorf("failed to update log timestamp for field %s: %w", fieldName, err)
-		}
-	}
-	return nil
-}
-
 func (r *aiGenerationRepository) GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
 	var user entity.User
 	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&user).Error; err != nil {
@@ -81,294 +37,42 @@ func (r *aiGenerationRepository) GetUserByID(ctx context.Context, userID uuid.UU
 	return &user, nil
 }
 
-// Supporterz用のマッピングヘルパー関数
-func (r *aiGenerationRepository) mapSupporterzFields(data map[string]interface{}, supporterzData *entity.Supporterz) {
-	mapStringField(data, "career_vision", &supporterzData.CareerVision)
-	mapStringField(data, "self_promotion", &supporterzData.SelfPromotion)
-
-	mapPQStringArrayField(data, "skills", &supporterzData.Skills)
-	mapPQStringArrayField(data, "skill_descriptions", &supporterzData.SkillDescriptions)
-	mapPQStringArrayField(data, "intern_experiences", &supporterzData.InternExperiences)
-	mapPQStringArrayField(data, "intern_experience_descriptions", &supporterzData.InternExperienceDescriptions)
-	mapPQStringArrayField(data, "products", &supporterzData.Products)
-	mapPQStringArrayField(data, "product_tech_stacks", &supporterzData.ProductTechStacks)
-	mapPQStringArrayField(data, "product_descriptions", &supporterzData.ProductDescriptions)
-	mapPQStringArrayField(data, "researches", &supporterzData.Researches)
-	mapPQStringArrayField(data, "research_descriptions", &supporterzData.ResearchDescriptions)
-}
-
-func (r *aiGenerationRepository) SaveSupporterzData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error {
-	supporterzData := &entity.Supporterz{
-		ID: userID, // user_idを直接IDとして使用
-	}
-
-	r.mapSupporterzFields(data, supporterzData)
-
-	// トランザクションを開始
-	tx := r.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// サービスデータを保存
-	if err := tx.Save(supporterzData).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to save supporterz data: %w", err)
-	}
-
-	// logsテーブルのupdated_atを更新
-	supporterzFields := []string{
-		"career_vision", "self_promotion", "skills", "skill_descriptions",
-		"intern_experiences", "intern_experience_descriptions", "products",
-		"product_tech_stacks", "product_descriptions", "researches", "research_descriptions",
-	}
-	if err := r.updateLogTimestamp(tx, userID, "supporterz", supporterzFields); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update log timestamp: %w", err)
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	return nil
-}
-
-// CareerSelect用のマッピングヘルパー関数
-func (r *aiGenerationRepository) mapCareerSelectFields(data map[string]interface{}, careerSelectData *entity.CareerSelect) {
-	mapStringField(data, "career_vision", &careerSelectData.CareerVision)
-	mapStringField(data, "self_promotion", &careerSelectData.SelfPromotion)
-	mapStringField(data, "research", &careerSelectData.Research)
-
-	mapPQStringArrayField(data, "skills", &careerSelectData.Skills)
-	mapPQStringArrayField(data, "skill_descriptions", &careerSelectData.SkillDescriptions)
-	mapPQStringArrayField(data, "company_selection_criteria", &careerSelectData.CompanySelectionCriteria)
-	mapPQStringArrayField(data, "company_selection_criteria_descriptions", &careerSelectData.CompanySelectionCriteriaDescriptions)
-	mapPQStringArrayField(data, "products", &careerSelectData.Products)
-	mapPQStringArrayField(data, "product_descriptions", &careerSelectData.ProductDescriptions)
-	mapPQStringArrayField(data, "experiences", &careerSelectData.Experiences)
-	mapPQStringArrayField(data, "experience_descriptions", &careerSelectData.ExperienceDescriptions)
-	mapPQStringArrayField(data, "intern_experiences", &careerSelectData.InternExperiences)
-	mapPQStringArrayField(data, "intern_experience_descriptions", &careerSelectData.InternExperienceDescriptions)
-	mapPQStringArrayField(data, "certifications", &careerSelectData.Certifications)
-	mapPQStringArrayField(data, "certification_descriptions", &careerSelectData.CertificationDescriptions)
-}
-
-func (r *aiGenerationRepository) SaveCareerSelectData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error {
-	careerSelectData := &entity.CareerSelect{
-		ID: userID, // user_idを直接IDとして使用
-	}
-
-	r.mapCareerSelectFields(data, careerSelectData)
-
-	// トランザクションを開始
-	tx := r.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+func (r *aiGenerationRepository) GetProfileByUserID(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	var profile entity.Profile
+	if err := r.db.WithContext(ctx).Where("id = ?", userID).First(&profile).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil // ESが未登録の場合はnilを返す
 		}
-	}()
-
-	// サービスデータを保存
-	if err := tx.Save(careerSelectData).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to save career select data: %w", err)
-	}
-
-	// logsテーブルのupdated_atを更新
-	careerSelectFields := []string{
-		"skills", "skill_descriptions", "company_selection_criteria",
-		"company_selection_criteria_descriptions", "career_vision", "self_promotion",
-		"research", "products", "product_descriptions", "experiences",
-		"experience_descriptions", "intern_experiences", "intern_experience_descriptions",
-		"certifications", "certification_descriptions",
-	}
-	if err := r.updateLogTimestamp(tx, userID, "career_select", careerSelectFields); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update log timestamp: %w", err)
+		return nil, fmt.Errorf("failed to get profile: %w", err)
 	}
-
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	return nil
-}
-
-// OneCareer用のマッピングヘルパー関数
-func (r *aiGenerationRepository) mapOneCareerFields(data map[string]interface{}, oneCareerData *entity.OneCareer) {
-	mapStringField(data, "engineer_aspiration", &oneCareerData.EngineerAspiration)
-
-	mapPQStringArrayField(data, "skills", &oneCareerData.Skills)
-	mapPQStringArrayField(data, "skill_descriptions", &oneCareerData.SkillDescriptions)
-	mapPQStringArrayField(data, "researches", &oneCareerData.Researches)
-	mapPQStringArrayField(data, "research_descriptions", &oneCareerData.ResearchDescriptions)
-	mapPQStringArrayField(data, "intern_experiences", &oneCareerData.InternExperiences)
-	mapPQStringArrayField(data, "intern_experience_descriptions", &oneCareerData.InternExperienceDescriptions)
-	mapPQStringArrayField(data, "products", &oneCareerData.Products)
-	mapPQStringArrayField(data, "product_descriptions", &oneCareerData.ProductDescriptions)
+	return &profile, nil
 }
 
-func (r *aiGenerationRepository) SaveOneCareerData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error {
-	oneCareerData := &entity.OneCareer{
-		ID: userID, // user_idを直接IDとして使用
-	}
-
-	r.mapOneCareerFields(data, oneCareerData)
-
-	// トランザクションを開始
-	tx := r.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+func (r *aiGenerationRepository) GetServiceVersion(ctx context.Context, def entity.ServiceDefinition, userID uuid.UUID) (int64, error) {
+	model := def.NewModel()
+	if err := r.db.WithContext(ctx).Select("version").Where("id = ?", userID).First(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
 		}
-	}()
-
-	// サービスデータを保存
-	if err := tx.Save(oneCareerData).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to save one career data: %w", err)
+		return 0, fmt.Errorf("failed to get %s version: %w", def.Key, err)
 	}
-
-	// logsテーブルのupdated_atを更新
-	oneCareerFields := []string{
-		"skills", "skill_descriptions", "researches", "research_descriptions",
-		"intern_experiences", "intern_experience_descriptions", "products",
-		"product_descriptions", "engineer_aspiration",
-	}
-	if err := r.updateLogTimestamp(tx, userID, "one_career", oneCareerFields); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update log timestamp: %w", err)
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-	return nil
+	return entity.ModelVersion(model), nil
 }
 
-func (r *aiGenerationRepository) SaveMynaviData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error {
-	mynaviData := &entity.Mynavi{
-		ID: userID, // user_idを直接IDとして使用
-	}
-
-	// データマッピング
-	if val, ok := data["self_promotion"].(string); ok {
-		mynaviData.SelfPromotion = val
-	}
-	if val, ok := data["future_plan"].(string); ok {
-		mynaviData.FuturePlan = val
-	}
-
-	// トランザクションを開始
-	tx := r.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+func (r *aiGenerationRepository) GetCustomServiceByID(ctx context.Context, id uuid.UUID) (*entity.CustomService, error) {
+	var customService entity.CustomService
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&customService).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // レコードが見つからない場合はnilを返す
 		}
-	}()
-
-	// サービスデータを保存
-	if err := tx.Save(mynaviData).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to save mynavi data: %w", err)
-	}
-
-	// logsテーブルのupdated_atを更新
-	mynaviFields := []string{"self_promotion", "future_plan"}
-	if err := r.updateLogTimestamp(tx, userID, "mynavi", mynaviFields); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update log timestamp: %w", err)
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return nil, fmt.Errorf("failed to get custom service: %w", err)
 	}
-	return nil
-}
-
-// LevtechRookie用のマッピングヘルパー関数
-func (r *aiGenerationRepository) mapLevtechRookieStringFields(data map[string]interface{}, levtechData *entity.LevtechRookie) {
-	mapStringField(data, "portfolio", &levtechData.Portfolio)
-	mapStringField(data, "portfolio_description", &levtechData.PortfolioDescription)
-	mapStringField(data, "research", &levtechData.Research)
-	mapStringField(data, "organization", &levtechData.Organization)
-	mapStringField(data, "other", &levtechData.Other)
-}
-
-func (r *aiGenerationRepository) mapLevtechRookieArrayFields(data map[string]interface{}, levtechData *entity.LevtechRookie) {
-	mapPQStringArrayField(data, "desired_job_type", &levtechData.DesiredJobType)
-	mapPQStringArrayField(data, "career_aspiration", &levtechData.CareerAspiration)
-	mapPQStringArrayField(data, "interested_tasks", &levtechData.InterestedTasks)
-	mapPQStringArrayField(data, "job_requirements", &levtechData.JobRequirements)
-	mapPQStringArrayField(data, "interested_industries", &levtechData.InterestedIndustries)
-	mapPQStringArrayField(data, "preferred_company_size", &levtechData.PreferredCompanySize)
-	mapPQStringArrayField(data, "interested_business_types", &levtechData.InterestedBusinessTypes)
-	mapPQStringArrayField(data, "preferred_work_location", &levtechData.PreferredWorkLocation)
-	mapPQStringArrayField(data, "skills", &levtechData.Skills)
-	mapPQStringArrayField(data, "skill_descriptions", &levtechData.SkillDescriptions)
-	mapPQStringArrayField(data, "intern_experiences", &levtechData.InternExperiences)
-	mapPQStringArrayField(data, "intern_experience_descriptions", &levtechData.InternExperienceDescriptions)
-	mapPQStringArrayField(data, "hackathon_experiences", &levtechData.HackathonExperiences)
-	mapPQStringArrayField(data, "hackathon_experience_descriptions", &levtechData.HackathonExperienceDescriptions)
-	mapPQStringArrayField(data, "certifications", &levtechData.Certifications)
-	mapPQStringArrayField(data, "languages", &levtechData.Languages)
-	mapPQStringArrayField(data, "language_levels", &levtechData.LanguageLevels)
+	return &customService, nil
 }
 
-func (r *aiGenerationRepository) SaveLevtechRookieData(ctx context.Context, userID uuid.UUID, data map[string]interface{}) error {
-	levtechData := &entity.LevtechRookie{
-		ID: userID, // user_idを直接IDとして使用
-	}
-
-	r.mapLevtechRookieStringFields(data, levtechData)
-	r.mapLevtechRookieArrayFields(data, levtechData)
-
-	// トランザクションを開始
-	tx := r.db.WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// サービスデータを保存
-	if err := tx.Save(levtechData).Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to save levtech rookie data: %w", err)
-	}
-
-	// logsテーブルのupdated_atを更新
-	levtechRookieFields := []string{
-		"desired_job_type", "career_aspiration", "interested_tasks", "job_requirements",
-		"interested_industries", "preferred_company_size", "interested_business_types",
-		"preferred_work_location", "skills", "skill_descriptions", "portfolio",
-		"portfolio_description", "intern_experiences", "intern_experience_descriptions",
-		"hackathon_experiences", "hackathon_experience_descriptions", "research",
-		"organization", "other", "certifications", "languages", "language_levels",
-	}
-	if err := r.updateLogTimestamp(tx, userID, "levtech_rookie", levtechRookieFields); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to update log timestamp: %w", err)
-	}
-
-	if err := tx.Commit().Error; err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+func (r *aiGenerationRepository) SaveGenerationHistory(ctx context.Context, history *entity.AIGenerationHistory) error {
+	if err := r.db.WithContext(ctx).Create(history).Error; err != nil {
+		return fmt.Errorf("failed to save generation history: %w", err)
 	}
 	return nil
 }
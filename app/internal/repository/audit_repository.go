@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+type AuditRepository interface {
+	CreateEvent(ctx context.Context, event *entity.AuditEvent) error
+	// 絞り込み条件に一致するユーザーの監査イベントを新しい順に取得
+	GetEvents(ctx context.Context, userID uuid.UUID, filter entity.AuditFilter) (*entity.AuditEventList, error)
+}
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+func (r *auditRepository) CreateEvent(ctx context.Context, event *entity.AuditEvent) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to create audit event: %w", err)
+	}
+	return nil
+}
+
+func (r *auditRepository) GetEvents(ctx context.Context, userID uuid.UUID, filter entity.AuditFilter) (*entity.AuditEventList, error) {
+	where, err := auditFilterScope(userID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// 件数と一覧は別の文で取得する（Count後のクエリを使い回すとSELECT count(*)のままになる）
+	list := &entity.AuditEventList{Events: make([]entity.AuditEvent, 0)}
+	if err := r.db.WithContext(ctx).Model(&entity.AuditEvent{}).Scopes(where).Count(&list.Total).Error; err != nil {
+		return nil, err
+	}
+	result := r.db.WithContext(ctx).Scopes(where).
+		Order("created_at DESC").
+		Order("id").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&list.Events)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return list, nil
+}
+
+// auditFilterScope 絞り込み条件のWHERE句
+func auditFilterScope(userID uuid.UUID, filter entity.AuditFilter) (func(*gorm.DB) *gorm.DB, error) {
+	var contains []byte
+	if filter.TargetTable != "" {
+		// changesの要素にtarget_tableが一致するものを含むイベント
+		var err error
+		contains, err = json.Marshal([]map[string]string{{"target_table": filter.TargetTable}})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("user_id = ?", userID)
+		if filter.ActorType != "" {
+			db = db.Where("actor_type = ?", filter.ActorType)
+		}
+		if filter.RequestID != "" {
+			db = db.Where("request_id = ?", filter.RequestID)
+		}
+		if contains != nil {
+			db = db.Where("changes @> CAST(? AS jsonb)", string(contains))
+		}
+		if filter.From != nil {
+			db = db.Where("created_at >= ?", *filter.From)
+		}
+		if filter.To != nil {
+			db = db.Where("created_at < ?", *filter.To)
+		}
+		return db
+	}, nil
+}
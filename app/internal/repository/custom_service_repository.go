@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+type CustomServiceRepository interface {
+	GetCustomServicesByUserID(ctx context.Context, userID uuid.UUID) ([]entity.CustomService, error)
+	GetCustomServiceByID(ctx context.Context, id uuid.UUID) (*entity.CustomService, error)
+	// currentVersionは保存の前提として読み込んだバージョン（新規作成の場合は0）
+	// 読み込み後に他の書き込み（AI生成など）でバージョンが進んでいた場合はErrVersionConflictを返す
+	CreateOrUpdateCustomService(ctx context.Context, customService *entity.CustomService, currentVersion int64) (*entity.CustomService, error)
+	DeleteCustomService(ctx context.Context, id uuid.UUID) error
+}
+
+type customServiceRepository struct {
+	db *gorm.DB
+}
+
+func NewCustomServiceRepository(db *gorm.DB) CustomServiceRepository {
+	return &customServiceRepository{db: db}
+}
+
+func (r *customServiceRepository) GetCustomServicesByUserID(ctx context.Context, userID uuid.UUID) ([]entity.CustomService, error) {
+	var customServices []entity.CustomService
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&customServices)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return customServices, nil
+}
+
+func (r *customServiceRepository) GetCustomServiceByID(ctx context.Context, id uuid.UUID) (*entity.CustomService, error) {
+	var customService entity.CustomService
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&customService)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil // レコードが見つからない場合はnilを返す
+		}
+		return nil, result.Error
+	}
+	return &customService, nil
+}
+
+func (r *customServiceRepository) CreateOrUpdateCustomService(ctx context.Context, customService *entity.CustomService, currentVersion int64) (*entity.CustomService, error) {
+	if err := saveVersioned(r.db.WithContext(ctx), customService, currentVersion); err != nil {
+		return nil, err
+	}
+	return customService, nil
+}
+
+func (r *customServiceRepository) DeleteCustomService(ctx context.Context, id uuid.UUID) error {
+	// カスタムサービス本体と更新ログをまとめて削除
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var customService entity.CustomService
+		if err := tx.Where("id = ?", id).First(&customService).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ? AND target_table = ?", customService.UserID, customService.LogTable()).Delete(&entity.Log{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&customService).Error
+	})
+}
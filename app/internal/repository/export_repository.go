@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+type ExportRepository interface {
+	GetUserExport(c *gin.Context, userID uuid.UUID) (*entity.UserExport, error)
+}
+
+type exportRepository struct {
+	db *gorm.DB
+}
+
+func NewExportRepository(db *gorm.DB) ExportRepository {
+	return &exportRepository{db: db}
+}
+
+func (r *exportRepository) GetUserExport(c *gin.Context, userID uuid.UUID) (*entity.UserExport, error) {
+	export := &entity.UserExport{
+		Services: make(map[string]interface{}),
+	}
+
+	// 全テーブルを同じ時点のスナップショットから読み込む
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var user entity.User
+		if err := tx.Where("user_id = ?", userID).First(&user).Error; err != nil {
+			return err
+		}
+		export.User = &user
+
+		var profile entity.Profile
+		if err := tx.Where("id = ?", userID).First(&profile).Error; err == nil {
+			export.Profile = &profile
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get profile: %w", err)
+		}
+
+		for _, def := range entity.Services {
+			model := def.NewModel()
+			if err := tx.Where("id = ?", userID).First(model).Error; err == nil {
+				export.Services[def.Key] = model
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("failed to get %s: %w", def.Key, err)
+			}
+		}
+
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&export.CustomServices).Error; err != nil {
+			return fmt.Errorf("failed to get custom services: %w", err)
+		}
+		if err := tx.Where("user_id = ?", userID).Order("slot").Find(&export.OfferBoxPhotos).Error; err != nil {
+			return fmt.Errorf("failed to get offerbox photos: %w", err)
+		}
+		if err := tx.Where("user_id = ?", userID).Order("updated_at").Find(&export.Logs).Error; err != nil {
+			return fmt.Errorf("failed to get logs: %w", err)
+		}
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&export.AIGenerationHistories).Error; err != nil {
+			return fmt.Errorf("failed to get AI generation histories: %w", err)
+		}
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&export.FieldRevisions).Error; err != nil {
+			return fmt.Errorf("failed to get field revisions: %w", err)
+		}
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&export.AuditEvents).Error; err != nil {
+			return fmt.Errorf("failed to get audit events: %w", err)
+		}
+		return nil
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // ユーザーが見つからない場合はnilを返す
+		}
+		return nil, err
+	}
+
+	export.ExportedAt = time.Now()
+	return export, nil
+}
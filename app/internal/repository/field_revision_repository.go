@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+type FieldRevisionRepository interface {
+	CreateRevisions(ctx context.Context, revisions []entity.FieldRevision) error
+	GetRevisions(ctx context.Context, userID uuid.UUID, targetTable, fieldName string) ([]entity.FieldRevision, error)
+	GetRevisionByID(ctx context.Context, id uuid.UUID) (*entity.FieldRevision, error)
+	// 項目をリビジョンの値に戻し、復元のリビジョンを記録
+	RevertField(ctx context.Context, revision *entity.FieldRevision) (*entity.FieldRevision, error)
+}
+
+type fieldRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewFieldRevisionRepository(db *gorm.DB) FieldRevisionRepository {
+	return &fieldRevisionRepository{db: db}
+}
+
+func (r *fieldRevisionRepository) CreateRevisions(ctx context.Context, revisions []entity.FieldRevision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&revisions).Error
+}
+
+func (r *fieldRevisionRepository) GetRevisions(ctx context.Context, userID uuid.UUID, targetTable, fieldName string) ([]entity.FieldRevision, error) {
+	var revisions []entity.FieldRevision
+	result := r.db.WithContext(ctx).Where("user_id = ? AND target_table = ? AND field_name = ?", userID, targetTable, fieldName).
+		Order("created_at DESC").
+		Find(&revisions)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return revisions, nil
+}
+
+func (r *fieldRevisionRepository) GetRevisionByID(ctx context.Context, id uuid.UUID) (*entity.FieldRevision, error) {
+	var revision entity.FieldRevision
+	result := r.db.WithContext(ctx).Where("id = ?", id).First(&revision)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil // レコードが見つからない場合はnilを返す
+		}
+		return nil, result.Error
+	}
+	return &revision, nil
+}
+
+func (r *fieldRevisionRepository) RevertField(ctx context.Context, revision *entity.FieldRevision) (*entity.FieldRevision, error) {
+	model, exists := entity.NewRevisionModel(revision.TargetTable)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidRevisionTarget, revision.TargetTable)
+	}
+	field, exists := entity.FindModelField(model, revision.FieldName)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s.%s", entity.ErrInvalidRevisionTarget, revision.TargetTable, revision.FieldName)
+	}
+
+	var reverted *entity.FieldRevision
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 現在のレコードを行ロック付きで取得（削除済みの場合は新規作成）
+		var before interface{}
+		current, _ := entity.NewRevisionModel(revision.TargetTable)
+		exists, err := lockForUpdate(tx, current, "id = ?", revision.UserID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			before = current
+			reflect.ValueOf(model).Elem().Set(reflect.ValueOf(current).Elem())
+		} else {
+			entity.SetModelID(model, revision.UserID)
+		}
+
+		if err := entity.SetFieldFromJSON(model, field, revision.NewValue); err != nil {
+			return err
+		}
+		if err := saveVersioned(tx, model, entity.ModelVersion(before)); err != nil {
+			return fmt.Errorf("failed to revert %s.%s: %w", revision.TargetTable, revision.FieldName, err)
+		}
+
+		// 復元も1つのリビジョンとして記録（値が変わらない場合も復元操作として残す）
+		revisions := entity.DiffFieldRevisions(revision.UserID, revision.TargetTable, entity.RevisionSourceRevert, before, model)
+		for i := range revisions {
+			if revisions[i].FieldName == revision.FieldName {
+				reverted = &revisions[i]
+			}
+		}
+		if reverted == nil {
+			reverted = &entity.FieldRevision{
+				ID:          uuid.New(),
+				UserID:      revision.UserID,
+				TargetTable: revision.TargetTable,
+				FieldName:   revision.FieldName,
+				OldValue:    revision.NewValue,
+				NewValue:    revision.NewValue,
+				Source:      entity.RevisionSourceRevert,
+				CreatedAt:   time.Now(),
+			}
+		}
+		if err := tx.Create(reverted).Error; err != nil {
+			return err
+		}
+		// 復元した項目のログも同じトランザクションで更新
+		return upsertLogs(tx, revision.UserID, revision.TargetTable, []string{revision.FieldName})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reverted, nil
+}
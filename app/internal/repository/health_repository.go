@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/infrastructure/migrate"
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// HealthRepository DB接続とスキーマの状態の確認
+type HealthRepository interface {
+	Ping(ctx context.Context) error
+	PoolStats() (*entity.DBPoolStats, error)
+	MigrationState(ctx context.Context) (*entity.MigrationState, error)
+}
+
+type healthRepository struct {
+	db *gorm.DB
+}
+
+func NewHealthRepository(db *gorm.DB) HealthRepository {
+	return &healthRepository{db: db}
+}
+
+func (r *healthRepository) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database instance: %w", err)
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (r *healthRepository) PoolStats() (*entity.DBPoolStats, error) {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database instance: %w", err)
+	}
+	stats := sqlDB.Stats()
+	return &entity.DBPoolStats{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDurationMS:     stats.WaitDuration.Milliseconds(),
+		MaxIdleClosed:      stats.MaxIdleClosed,
+		MaxIdleTimeClosed:  stats.MaxIdleTimeClosed,
+		MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+	}, nil
+}
+
+// MigrationState 埋め込まれたマイグレーションのうち未適用のものを取得
+func (r *healthRepository) MigrationState(ctx context.Context) (*entity.MigrationState, error) {
+	migrations, err := migrate.Migrations()
+	if err != nil {
+		return nil, err
+	}
+	pending, err := migrate.Pending(r.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	state := &entity.MigrationState{Pending: make([]string, 0, len(pending))}
+	if len(migrations) > 0 {
+		state.LatestVersion = migrations[len(migrations)-1].Version
+	}
+	for _, m := range pending {
+		state.Pending = append(state.Pending, fmt.Sprintf("%04d_%s", m.Version, m.Name))
+	}
+	return state, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+type ImportRepository interface {
+	// ユーザー・ES・各サービス・カスタムサービス・写真を1トランザクションでupsert
+	ApplyImport(c *gin.Context, data *entity.UserExport) error
+}
+
+type importRepository struct {
+	db *gorm.DB
+}
+
+func NewImportRepository(db *gorm.DB) ImportRepository {
+	return &importRepository{db: db}
+}
+
+func (r *importRepository) ApplyImport(c *gin.Context, data *entity.UserExport) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// 外部キーの親となるusersを最初に保存
+		if err := saveImported(tx, data.User, "user_id = ?", data.User.UserID); err != nil {
+			return fmt.Errorf("failed to import user: %w", err)
+		}
+
+		if data.Profile != nil {
+			if err := saveImported(tx, data.Profile, "id = ?", data.Profile.ID); err != nil {
+				return fmt.Errorf("failed to import profile: %w", err)
+			}
+		}
+
+		// レジストリ順に保存
+		for _, def := range entity.Services {
+			model, exists := data.Services[def.Key]
+			if !exists {
+				continue
+			}
+			if err := saveImported(tx, model, "id = ?", data.User.UserID); err != nil {
+				return fmt.Errorf("failed to import %s: %w", def.Key, err)
+			}
+		}
+
+		for i := range data.CustomServices {
+			// 同じIDの他のユーザーのカスタムサービスを上書きしない（アーカイブのIDは書き換えられる）
+			var existing entity.CustomService
+			exists, err := lockForUpdate(tx, &existing, "id = ?", data.CustomServices[i].ID)
+			if err != nil {
+				return fmt.Errorf("failed to import custom service %s: %w", data.CustomServices[i].ID, err)
+			}
+			if exists && existing.UserID != data.User.UserID {
+				return fmt.Errorf("%w: custom service %s belongs to another user", entity.ErrInvalidImportArchive, data.CustomServices[i].ID)
+			}
+			var currentVersion int64
+			if exists {
+				currentVersion = existing.Version
+			}
+			if err := saveVersioned(tx, &data.CustomServices[i], currentVersion); err != nil {
+				return fmt.Errorf("failed to import custom service %s: %w", data.CustomServices[i].ID, err)
+			}
+		}
+
+		for i := range data.OfferBoxPhotos {
+			if err := tx.Save(&data.OfferBoxPhotos[i]).Error; err != nil {
+				return fmt.Errorf("failed to import offerbox photo %d: %w", data.OfferBoxPhotos[i].Slot, err)
+			}
+		}
+		return nil
+	})
+}
+
+// 現在のバージョンから1つ進めて保存（アーカイブ内のバージョンは使用しない）
+func saveImported(tx *gorm.DB, model interface{}, query string, args ...interface{}) error {
+	current := reflect.New(reflect.TypeOf(model).Elem()).Interface()
+	exists, err := lockForUpdate(tx, current, query, args...)
+	if err != nil {
+		return err
+	}
+	var currentVersion int64
+	if exists {
+		currentVersion = entity.ModelVersion(current)
+	}
+	return saveVersioned(tx, model, currentVersion)
+}
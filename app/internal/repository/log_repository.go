@@ -1,12 +1,13 @@
 package repository
 
 import (
+	"context"
+	"fmt"
 	"time"
 
-	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	"gorm.io/gorm/clause"
 
 	"job-hunting-service-management-backend/app/internal/entity"
 )
@@ -16,8 +17,13 @@ var (
 )
 
 type LogRepository interface {
-	GetLogsByUserID(c *gin.Context, userID uuid.UUID) ([]entity.Log, error)
-	UpsertLog(c *gin.Context, userID uuid.UUID, targetTable, fieldName string) error
+	// 絞り込み条件に一致するユーザーのログを並び順どおりに取得し、条件に一致する件数も返す
+	GetLogs(ctx context.Context, userID uuid.UUID, filter entity.LogFilter) ([]entity.Log, int64, error)
+	UpsertLog(ctx context.Context, userID uuid.UUID, targetTable, fieldName string) error
+	// 複数フィールドのログをまとめて更新
+	UpsertLogs(ctx context.Context, userID uuid.UUID, targetTable string, fieldNames []string) error
+	// 記録日時を指定してログをupsert（シードデータの投入用）
+	RestoreLogs(ctx context.Context, logs []entity.Log) error
 }
 
 type logRepository struct {
@@ -28,38 +34,114 @@ func NewLogRepository(d *gorm.DB) LogRepository {
 	return &logRepository{db: d}
 }
 
-func (r *logRepository) GetLogsByUserID(c *gin.Context, userID uuid.UUID) ([]entity.Log, error) {
-	var logs []entity.Log
-	result := r.db.Where("user_id = ?", userID).Find(&logs)
-	if result.Error != nil {
-		return nil, result.Error
+func (r *logRepository) GetLogs(ctx context.Context, userID uuid.UUID, filter entity.LogFilter) ([]entity.Log, int64, error) {
+	where := logFilterScope(userID, filter)
+
+	// 件数と一覧は別の文で取得する（Count後のクエリを使い回すとSELECT count(*)のままになる）
+	var total int64
+	if err := r.db.WithContext(ctx).Model(&entity.Log{}).Scopes(where).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	query := r.db.WithContext(ctx).Scopes(where)
+	for _, order := range logOrder(filter) {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: order.key}, Desc: order.desc})
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	logs := make([]entity.Log, 0)
+	if err := query.Offset(filter.Offset).Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+// logFilterScope 絞り込み条件のWHERE句
+func logFilterScope(userID uuid.UUID, filter entity.LogFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("user_id = ?", userID)
+		if len(filter.TargetTables) > 0 {
+			db = db.Where("target_table IN ?", filter.TargetTables)
+		}
+		if len(filter.FieldNames) > 0 {
+			db = db.Where("field_name IN ?", filter.FieldNames)
+		}
+		if filter.From != nil {
+			db = db.Where("updated_at >= ?", *filter.From)
+		}
+		if filter.To != nil {
+			db = db.Where("updated_at < ?", *filter.To)
+		}
+		return db
 	}
-	return logs, nil
 }
 
-func (r *logRepository) UpsertLog(c *gin.Context, userID uuid.UUID, targetTable, fieldName string) error {
-	// 既存のレコードを検索（ログレベルを一時的に下げる）
-	var existingLog entity.Log
-	result := r.db.Session(&gorm.Session{Logger: r.db.Logger.LogMode(logger.Silent)}).
-		Where("user_id = ? AND target_table = ? AND field_name = ?", userID, targetTable, fieldName).
-		First(&existingLog)
+// logSortOrder 並び順の1つのキー
+type logSortOrder struct {
+	key  string
+	desc bool
+}
 
-	if result.Error != nil && result.Error != gorm.ErrRecordNotFound {
-		return result.Error
+// logOrder 指定された並び順に、同じ値の行の順序を固定するためのキーを加える
+func logOrder(filter entity.LogFilter) []logSortOrder {
+	key, desc := filter.SortKey()
+	orders := []logSortOrder{{key: key, desc: desc}}
+	for _, tieBreaker := range []string{entity.LogSortTargetTable, entity.LogSortFieldName} {
+		if tieBreaker != key {
+			orders = append(orders, logSortOrder{key: tieBreaker})
+		}
 	}
+	return orders
+}
 
-	if result.Error == gorm.ErrRecordNotFound {
-		// レコードが存在しない場合は新規作成
-		newLog := entity.Log{
+func (r *logRepository) UpsertLog(ctx context.Context, userID uuid.UUID, targetTable, fieldName string) error {
+	return upsertLogs(r.db.WithContext(ctx), userID, targetTable, []string{fieldName})
+}
+
+func (r *logRepository) UpsertLogs(ctx context.Context, userID uuid.UUID, targetTable string, fieldNames []string) error {
+	return upsertLogs(r.db.WithContext(ctx), userID, targetTable, fieldNames)
+}
+
+func (r *logRepository) RestoreLogs(ctx context.Context, logs []entity.Log) error {
+	if err := upsertLogRows(r.db.WithContext(ctx), logs); err != nil {
+		return fmt.Errorf("failed to restore logs: %w", err)
+	}
+	return nil
+}
+
+// upsertLogs 各フィールドのログを1つのINSERT ... ON CONFLICTでまとめて作成し、既存の場合はupdated_atのみ更新
+// トランザクションを渡すと、データの保存と同時にコミット・ロールバックされる
+func upsertLogs(db *gorm.DB, userID uuid.UUID, targetTable string, fieldNames []string) error {
+	now := time.Now().In(JST)
+	logs := make([]entity.Log, 0, len(fieldNames))
+	seen := make(map[string]bool, len(fieldNames))
+	for _, fieldName := range fieldNames {
+		// 同じ行を1つの文で2回更新するとエラーになるため重複を除く
+		if seen[fieldName] {
+			continue
+		}
+		seen[fieldName] = true
+		logs = append(logs, entity.Log{
 			ID:          uuid.New(),
 			UserID:      userID,
 			TargetTable: targetTable,
 			FieldName:   fieldName,
-			UpdatedAt:   time.Now().In(JST),
-		}
-		return r.db.Create(&newLog).Error
-	} else {
-		// レコードが存在する場合はupdated_atのみ更新
-		return r.db.Model(&existingLog).Update("updated_at", time.Now().In(JST)).Error
+			UpdatedAt:   now,
+		})
+	}
+	if err := upsertLogRows(db, logs); err != nil {
+		return fmt.Errorf("failed to upsert logs for %s: %w", targetTable, err)
+	}
+	return nil
+}
+
+// upsertLogRows ログを1つのINSERT ... ON CONFLICTで保存し、既存の場合はupdated_atのみ更新
+func upsertLogRows(db *gorm.DB, logs []entity.Log) error {
+	if len(logs) == 0 {
+		return nil
 	}
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_table"}, {Name: "field_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+	}).Create(&logs).Error
 }
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type aiGenerationRepository struct {
+	s *Store
+}
+
+func NewAIGenerationRepository(s *Store) repository.AIGenerationRepository {
+	return &aiGenerationRepository{s: s}
+}
+
+func (r *aiGenerationRepository) GetUserByID(ctx context.Context, userID uuid.UUID) (*entity.User, error) {
+	var user *entity.User
+	err := r.s.do(func(t *tables) error {
+		row, exists := t.get(usersTable, userID)
+		if !exists {
+			return fmt.Errorf("failed to get user: %w", gorm.ErrRecordNotFound)
+		}
+		user = row.(*entity.User)
+		return nil
+	})
+	return user, err
+}
+
+func (r *aiGenerationRepository) GetProfileByUserID(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	return NewProfileRepository(r.s).GetProfileByUserID(ctx, userID) // ESが未登録の場合はnilを返す
+}
+
+func (r *aiGenerationRepository) GetServiceVersion(ctx context.Context, def entity.ServiceDefinition, userID uuid.UUID) (int64, error) {
+	var version int64
+	err := r.s.do(func(t *tables) error {
+		version = entity.ModelVersion(t.rows[tableName(def.NewModel())][userID])
+		return nil
+	})
+	return version, err
+}
+
+func (r *aiGenerationRepository) GetCustomServiceByID(ctx context.Context, id uuid.UUID) (*entity.CustomService, error) {
+	customService, err := NewCustomServiceRepository(r.s).GetCustomServiceByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom service: %w", err)
+	}
+	return customService, nil
+}
+
+func (r *aiGenerationRepository) SaveGenerationHistory(ctx context.Context, history *entity.AIGenerationHistory) error {
+	return r.s.do(func(t *tables) error {
+		touchTimestamps(history, true)
+		t.aiHistories = append(t.aiHistories, clone(history))
+		return nil
+	})
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type auditRepository struct {
+	s *Store
+}
+
+func NewAuditRepository(s *Store) repository.AuditRepository {
+	return &auditRepository{s: s}
+}
+
+func (r *auditRepository) CreateEvent(ctx context.Context, event *entity.AuditEvent) error {
+	return r.s.do(func(t *tables) error {
+		t.auditEvents = append(t.auditEvents, clone(event))
+		return nil
+	})
+}
+
+func (r *auditRepository) GetEvents(ctx context.Context, userID uuid.UUID, filter entity.AuditFilter) (*entity.AuditEventList, error) {
+	events := make([]entity.AuditEvent, 0)
+	err := r.s.do(func(t *tables) error {
+		for _, event := range t.auditEvents {
+			if event.UserID == userID && matchesAuditFilter(event, filter) {
+				events = append(events, *clone(event))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 新しい順
+	sort.SliceStable(events, func(i, j int) bool { return events[i].CreatedAt.After(events[j].CreatedAt) })
+	list := &entity.AuditEventList{Total: int64(len(events)), Events: make([]entity.AuditEvent, 0)}
+	if filter.Offset < len(events) {
+		end := min(filter.Offset+filter.Limit, len(events))
+		list.Events = events[filter.Offset:end]
+	}
+	return list, nil
+}
+
+// matchesAuditFilter 監査イベントが絞り込み条件に一致するか
+func matchesAuditFilter(event *entity.AuditEvent, filter entity.AuditFilter) bool {
+	if filter.ActorType != "" && event.ActorType != filter.ActorType {
+		return false
+	}
+	if filter.RequestID != "" && event.RequestID != filter.RequestID {
+		return false
+	}
+	if filter.From != nil && event.CreatedAt.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && !event.CreatedAt.Before(*filter.To) {
+		return false
+	}
+	if filter.TargetTable == "" {
+		return true
+	}
+	for _, change := range event.Changes {
+		if change.TargetTable == filter.TargetTable {
+			return true
+		}
+	}
+	return false
+}
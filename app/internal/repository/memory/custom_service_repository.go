@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type customServiceRepository struct {
+	s *Store
+}
+
+func NewCustomServiceRepository(s *Store) repository.CustomServiceRepository {
+	return &customServiceRepository{s: s}
+}
+
+func (r *customServiceRepository) GetCustomServicesByUserID(ctx context.Context, userID uuid.UUID) ([]entity.CustomService, error) {
+	var customServices []entity.CustomService
+	err := r.s.do(func(t *tables) error {
+		customServices = t.customServicesOf(userID)
+		return nil
+	})
+	return customServices, err
+}
+
+func (r *customServiceRepository) GetCustomServiceByID(ctx context.Context, id uuid.UUID) (*entity.CustomService, error) {
+	var customService *entity.CustomService
+	err := r.s.do(func(t *tables) error {
+		if cs, exists := t.customServices[id]; exists {
+			customService = clone(cs)
+		}
+		return nil
+	})
+	return customService, err // レコードが見つからない場合はnilを返す
+}
+
+func (r *customServiceRepository) CreateOrUpdateCustomService(ctx context.Context, customService *entity.CustomService, currentVersion int64) (*entity.CustomService, error) {
+	err := r.s.do(func(t *tables) error {
+		return t.saveCustomService(customService, currentVersion)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return customService, nil
+}
+
+func (r *customServiceRepository) DeleteCustomService(ctx context.Context, id uuid.UUID) error {
+	return r.s.do(func(t *tables) error {
+		customService, exists := t.customServices[id]
+		if !exists {
+			return gorm.ErrRecordNotFound
+		}
+		// カスタムサービス本体と更新ログをまとめて削除
+		for key := range t.logs {
+			if key.userID == customService.UserID && key.targetTable == customService.LogTable() {
+				delete(t.logs, key)
+			}
+		}
+		delete(t.customServices, id)
+		return nil
+	})
+}
+
+// customServicesOf ユーザーのカスタムサービスを作成日時の順に取得
+func (t *tables) customServicesOf(userID uuid.UUID) []entity.CustomService {
+	customServices := make([]entity.CustomService, 0)
+	for _, cs := range t.customServices {
+		if cs.UserID == userID {
+			customServices = append(customServices, *clone(cs))
+		}
+	}
+	sort.Slice(customServices, func(i, j int) bool {
+		return customServices[i].CreatedAt.Before(customServices[j].CreatedAt)
+	})
+	return customServices
+}
+
+// saveCustomService 楽観的ロックでidにupsertし、バージョンを1つ進める（repository.saveVersionedと同じ動作）
+func (t *tables) saveCustomService(customService *entity.CustomService, currentVersion int64) error {
+	current, exists := t.customServices[customService.ID]
+	if currentVersion == 0 && exists {
+		return fmt.Errorf("%w: record was created concurrently", entity.ErrVersionConflict)
+	}
+	if currentVersion != 0 && (!exists || current.Version != currentVersion) {
+		return fmt.Errorf("%w: record was updated concurrently", entity.ErrVersionConflict)
+	}
+
+	customService.Version = currentVersion + 1
+	touchTimestamps(customService, !exists)
+	t.customServices[customService.ID] = clone(customService)
+	return nil
+}
@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type exportRepository struct {
+	s *Store
+}
+
+func NewExportRepository(s *Store) repository.ExportRepository {
+	return &exportRepository{s: s}
+}
+
+func (r *exportRepository) GetUserExport(c *gin.Context, userID uuid.UUID) (*entity.UserExport, error) {
+	var export *entity.UserExport
+	err := r.s.do(func(t *tables) error {
+		user, exists := t.get(usersTable, userID)
+		if !exists {
+			return nil // ユーザーが見つからない場合はnilを返す
+		}
+
+		export = &entity.UserExport{
+			User:     user.(*entity.User),
+			Services: make(map[string]interface{}),
+		}
+		if profile, exists := t.get(entity.ProfileTargetTable, userID); exists {
+			export.Profile = profile.(*entity.Profile)
+		}
+		for _, def := range entity.Services {
+			if model, exists := t.get(tableName(def.NewModel()), userID); exists {
+				export.Services[def.Key] = model
+			}
+		}
+		export.CustomServices = t.customServicesOf(userID)
+		export.OfferBoxPhotos = t.photosOf(userID)
+		export.Logs = t.logsOf(userID)
+		for _, history := range t.aiHistories {
+			if history.UserID == userID {
+				export.AIGenerationHistories = append(export.AIGenerationHistories, *clone(history))
+			}
+		}
+		sort.SliceStable(export.AIGenerationHistories, func(i, j int) bool {
+			return export.AIGenerationHistories[i].CreatedAt.Before(export.AIGenerationHistories[j].CreatedAt)
+		})
+		for _, revision := range t.fieldRevisions {
+			if revision.UserID == userID {
+				export.FieldRevisions = append(export.FieldRevisions, *clone(revision))
+			}
+		}
+		sort.SliceStable(export.FieldRevisions, func(i, j int) bool {
+			return export.FieldRevisions[i].CreatedAt.Before(export.FieldRevisions[j].CreatedAt)
+		})
+		for _, event := range t.auditEvents {
+			if event.UserID == userID {
+				export.AuditEvents = append(export.AuditEvents, *clone(event))
+			}
+		}
+		sort.SliceStable(export.AuditEvents, func(i, j int) bool {
+			return export.AuditEvents[i].CreatedAt.Before(export.AuditEvents[j].CreatedAt)
+		})
+		return nil
+	})
+	if err != nil || export == nil {
+		return nil, err
+	}
+
+	export.ExportedAt = time.Now()
+	return export, nil
+}
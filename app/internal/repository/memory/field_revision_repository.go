@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type fieldRevisionRepository struct {
+	s *Store
+}
+
+func NewFieldRevisionRepository(s *Store) repository.FieldRevisionRepository {
+	return &fieldRevisionRepository{s: s}
+}
+
+func (r *fieldRevisionRepository) CreateRevisions(ctx context.Context, revisions []entity.FieldRevision) error {
+	return r.s.do(func(t *tables) error {
+		for i := range revisions {
+			t.fieldRevisions = append(t.fieldRevisions, clone(&revisions[i]))
+		}
+		return nil
+	})
+}
+
+func (r *fieldRevisionRepository) GetRevisions(ctx context.Context, userID uuid.UUID, targetTable, fieldName string) ([]entity.FieldRevision, error) {
+	var revisions []entity.FieldRevision
+	err := r.s.do(func(t *tables) error {
+		for _, revision := range t.fieldRevisions {
+			if revision.UserID == userID && revision.TargetTable == targetTable && revision.FieldName == fieldName {
+				revisions = append(revisions, *clone(revision))
+			}
+		}
+		return nil
+	})
+	// 新しい順
+	sort.SliceStable(revisions, func(i, j int) bool { return revisions[i].CreatedAt.After(revisions[j].CreatedAt) })
+	return revisions, err
+}
+
+func (r *fieldRevisionRepository) GetRevisionByID(ctx context.Context, id uuid.UUID) (*entity.FieldRevision, error) {
+	var found *entity.FieldRevision
+	err := r.s.do(func(t *tables) error {
+		for _, revision := range t.fieldRevisions {
+			if revision.ID == id {
+				found = clone(revision)
+			}
+		}
+		return nil
+	})
+	return found, err // レコードが見つからない場合はnilを返す
+}
+
+func (r *fieldRevisionRepository) RevertField(ctx context.Context, revision *entity.FieldRevision) (*entity.FieldRevision, error) {
+	model, exists := entity.NewRevisionModel(revision.TargetTable)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", entity.ErrInvalidRevisionTarget, revision.TargetTable)
+	}
+	field, exists := entity.FindModelField(model, revision.FieldName)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s.%s", entity.ErrInvalidRevisionTarget, revision.TargetTable, revision.FieldName)
+	}
+
+	var reverted *entity.FieldRevision
+	err := r.s.atomic(func(t *tables) error {
+		// 現在のレコードを取得（削除済みの場合は新規作成）
+		var before interface{}
+		if current, exists := t.get(tableName(model), revision.UserID); exists {
+			before = current
+			reflect.ValueOf(model).Elem().Set(reflect.ValueOf(clone(current)).Elem())
+		} else {
+			entity.SetModelID(model, revision.UserID)
+		}
+
+		if err := entity.SetFieldFromJSON(model, field, revision.NewValue); err != nil {
+			return err
+		}
+		if err := t.saveVersioned(model, entity.ModelVersion(before)); err != nil {
+			return fmt.Errorf("failed to revert %s.%s: %w", revision.TargetTable, revision.FieldName, err)
+		}
+
+		// 復元も1つのリビジョンとして記録（値が変わらない場合も復元操作として残す）
+		revisions := entity.DiffFieldRevisions(revision.UserID, revision.TargetTable, entity.RevisionSourceRevert, before, model)
+		for i := range revisions {
+			if revisions[i].FieldName == revision.FieldName {
+				reverted = &revisions[i]
+			}
+		}
+		if reverted == nil {
+			reverted = &entity.FieldRevision{
+				ID:          uuid.New(),
+				UserID:      revision.UserID,
+				TargetTable: revision.TargetTable,
+				FieldName:   revision.FieldName,
+				OldValue:    revision.NewValue,
+				NewValue:    revision.NewValue,
+				Source:      entity.RevisionSourceRevert,
+				CreatedAt:   time.Now(),
+			}
+		}
+		t.fieldRevisions = append(t.fieldRevisions, clone(reverted))
+		t.upsertLogs(revision.UserID, revision.TargetTable, []string{revision.FieldName})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reverted, nil
+}
@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"context"
+	"errors"
+
+	"job-hunting-service-management-backend/app/infrastructure/migrate"
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// errNoConnectionPool メモリ上のデータストアにはDBコネクションプールがない
+var errNoConnectionPool = errors.New("in-memory store has no connection pool")
+
+type healthRepository struct{}
+
+func NewHealthRepository() repository.HealthRepository {
+	return &healthRepository{}
+}
+
+// Ping メモリ上のデータストアは常に利用できる
+func (r *healthRepository) Ping(ctx context.Context) error {
+	return nil
+}
+
+func (r *healthRepository) PoolStats() (*entity.DBPoolStats, error) {
+	return nil, errNoConnectionPool
+}
+
+// MigrationState スキーマを持たないため、全マイグレーションを適用済みとして扱う
+func (r *healthRepository) MigrationState(ctx context.Context) (*entity.MigrationState, error) {
+	migrations, err := migrate.Migrations()
+	if err != nil {
+		return nil, err
+	}
+	state := &entity.MigrationState{Pending: []string{}}
+	if len(migrations) > 0 {
+		state.LatestVersion = migrations[len(migrations)-1].Version
+	}
+	return state, nil
+}
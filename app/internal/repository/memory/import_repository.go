@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type importRepository struct {
+	s *Store
+}
+
+func NewImportRepository(s *Store) repository.ImportRepository {
+	return &importRepository{s: s}
+}
+
+func (r *importRepository) ApplyImport(c *gin.Context, data *entity.UserExport) error {
+	return r.s.atomic(func(t *tables) error {
+		if err := t.saveImported(data.User); err != nil {
+			return fmt.Errorf("failed to import user: %w", err)
+		}
+
+		if data.Profile != nil {
+			if err := t.saveImported(data.Profile); err != nil {
+				return fmt.Errorf("failed to import profile: %w", err)
+			}
+		}
+
+		// レジストリ順に保存
+		for _, def := range entity.Services {
+			model, exists := data.Services[def.Key]
+			if !exists {
+				continue
+			}
+			if err := t.saveImported(model); err != nil {
+				return fmt.Errorf("failed to import %s: %w", def.Key, err)
+			}
+		}
+
+		for i := range data.CustomServices {
+			// 同じIDの他のユーザーのカスタムサービスを上書きしない（アーカイブのIDは書き換えられる）
+			existing, exists := t.customServices[data.CustomServices[i].ID]
+			if exists && existing.UserID != data.User.UserID {
+				return fmt.Errorf("%w: custom service %s belongs to another user", entity.ErrInvalidImportArchive, data.CustomServices[i].ID)
+			}
+			if err := t.saveCustomService(&data.CustomServices[i], entity.ModelVersion(existing)); err != nil {
+				return fmt.Errorf("failed to import custom service %s: %w", data.CustomServices[i].ID, err)
+			}
+		}
+		for i := range data.OfferBoxPhotos {
+			t.savePhoto(&data.OfferBoxPhotos[i])
+		}
+		return nil
+	})
+}
+
+// saveImported 現在のバージョンから1つ進めて保存（アーカイブ内のバージョンは使用しない）
+func (t *tables) saveImported(model interface{}) error {
+	current := t.rows[tableName(model)][modelID(model)]
+	return t.saveVersioned(model, entity.ModelVersion(current))
+}
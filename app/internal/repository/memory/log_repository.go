@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type logRepository struct {
+	s *Store
+}
+
+func NewLogRepository(s *Store) repository.LogRepository {
+	return &logRepository{s: s}
+}
+
+func (r *logRepository) GetLogs(ctx context.Context, userID uuid.UUID, filter entity.LogFilter) ([]entity.Log, int64, error) {
+	var logs []entity.Log
+	err := r.s.do(func(t *tables) error {
+		for _, l := range t.logsOf(userID) {
+			if matchesLogFilter(l, filter) {
+				logs = append(logs, l)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	key, desc := filter.SortKey()
+	sort.SliceStable(logs, func(i, j int) bool {
+		if cmp := compareLogs(logs[i], logs[j], key); cmp != 0 {
+			return (cmp < 0) != desc
+		}
+		// 同じ値の行は対象テーブル・項目名の順（DBの実装と同じ）
+		if cmp := strings.Compare(logs[i].TargetTable, logs[j].TargetTable); cmp != 0 {
+			return cmp < 0
+		}
+		return logs[i].FieldName < logs[j].FieldName
+	})
+
+	total := int64(len(logs))
+	page := make([]entity.Log, 0)
+	if filter.Offset < len(logs) {
+		end := len(logs)
+		if filter.Limit > 0 {
+			end = min(filter.Offset+filter.Limit, len(logs))
+		}
+		page = logs[filter.Offset:end]
+	}
+	return page, total, nil
+}
+
+// matchesLogFilter ログが絞り込み条件に一致するか
+func matchesLogFilter(l entity.Log, filter entity.LogFilter) bool {
+	if len(filter.TargetTables) > 0 && !slices.Contains(filter.TargetTables, l.TargetTable) {
+		return false
+	}
+	if len(filter.FieldNames) > 0 && !slices.Contains(filter.FieldNames, l.FieldName) {
+		return false
+	}
+	if filter.From != nil && l.UpdatedAt.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && !l.UpdatedAt.Before(*filter.To) {
+		return false
+	}
+	return true
+}
+
+// compareLogs 並び順のキーでログを比較
+func compareLogs(a, b entity.Log, key string) int {
+	switch key {
+	case entity.LogSortTargetTable:
+		return strings.Compare(a.TargetTable, b.TargetTable)
+	case entity.LogSortFieldName:
+		return strings.Compare(a.FieldName, b.FieldName)
+	default:
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	}
+}
+
+func (r *logRepository) UpsertLog(ctx context.Context, userID uuid.UUID, targetTable, fieldName string) error {
+	return r.UpsertLogs(ctx, userID, targetTable, []string{fieldName})
+}
+
+func (r *logRepository) UpsertLogs(ctx context.Context, userID uuid.UUID, targetTable string, fieldNames []string) error {
+	return r.s.do(func(t *tables) error {
+		t.upsertLogs(userID, targetTable, fieldNames)
+		return nil
+	})
+}
+
+func (r *logRepository) RestoreLogs(ctx context.Context, logs []entity.Log) error {
+	return r.s.do(func(t *tables) error {
+		for _, l := range logs {
+			key := logKey{userID: l.UserID, targetTable: l.TargetTable, fieldName: l.FieldName}
+			if current, exists := t.logs[key]; exists {
+				current.UpdatedAt = l.UpdatedAt
+				continue
+			}
+			restored := l
+			t.logs[key] = &restored
+		}
+		return nil
+	})
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type offerBoxPhotoRepository struct {
+	s *Store
+}
+
+func NewOfferBoxPhotoRepository(s *Store) repository.OfferBoxPhotoRepository {
+	return &offerBoxPhotoRepository{s: s}
+}
+
+func (r *offerBoxPhotoRepository) GetPhotosByUserID(ctx context.Context, userID uuid.UUID) ([]entity.OfferBoxPhoto, error) {
+	var photos []entity.OfferBoxPhoto
+	err := r.s.do(func(t *tables) error {
+		photos = t.photosOf(userID)
+		// 一覧では画像データ本体は返さない
+		for i := range photos {
+			photos[i].Data = nil
+		}
+		return nil
+	})
+	return photos, err
+}
+
+func (r *offerBoxPhotoRepository) GetPhoto(ctx context.Context, userID uuid.UUID, slot int) (*entity.OfferBoxPhoto, error) {
+	var photo *entity.OfferBoxPhoto
+	err := r.s.do(func(t *tables) error {
+		if p, exists := t.offerBoxPhotos[photoKey{userID: userID, slot: slot}]; exists {
+			photo = clone(p)
+		}
+		return nil
+	})
+	return photo, err // レコードが見つからない場合はnilを返す
+}
+
+func (r *offerBoxPhotoRepository) GetPhotoCaptions(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	var captions []string
+	err := r.s.do(func(t *tables) error {
+		if row, exists := t.get(entity.OfferBox{}.TableName(), userID); exists {
+			captions = row.(*entity.OfferBox).PhotoCaptions
+		}
+		return nil
+	})
+	return captions, err // OfferBoxが未登録の場合はキャプションなし
+}
+
+func (r *offerBoxPhotoRepository) SavePhoto(ctx context.Context, photo *entity.OfferBoxPhoto) (*entity.OfferBoxPhoto, error) {
+	err := r.s.do(func(t *tables) error {
+		t.savePhoto(photo)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return photo, nil
+}
+
+func (r *offerBoxPhotoRepository) DeletePhoto(ctx context.Context, userID uuid.UUID, slot int) error {
+	return r.s.do(func(t *tables) error {
+		delete(t.offerBoxPhotos, photoKey{userID: userID, slot: slot})
+		return nil
+	})
+}
+
+// photosOf ユーザーの写真を写真枠の順に取得（画像データを含む）
+func (t *tables) photosOf(userID uuid.UUID) []entity.OfferBoxPhoto {
+	photos := make([]entity.OfferBoxPhoto, 0)
+	for key, p := range t.offerBoxPhotos {
+		if key.userID == userID {
+			photos = append(photos, *clone(p))
+		}
+	}
+	sort.Slice(photos, func(i, j int) bool { return photos[i].Slot < photos[j].Slot })
+	return photos
+}
+
+// savePhoto user_idとslotでupsert
+func (t *tables) savePhoto(photo *entity.OfferBoxPhoto) {
+	photo.UpdatedAt = time.Now()
+	t.offerBoxPhotos[photoKey{userID: photo.UserID, slot: photo.Slot}] = clone(photo)
+}
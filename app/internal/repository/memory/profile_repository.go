@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type profileRepository struct {
+	s *Store
+}
+
+func NewProfileRepository(s *Store) repository.ProfileRepository {
+	return &profileRepository{s: s}
+}
+
+func (r *profileRepository) GetProfileByUserID(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
+	var profile *entity.Profile
+	err := r.s.do(func(t *tables) error {
+		if row, exists := t.get(entity.ProfileTargetTable, userID); exists {
+			profile = row.(*entity.Profile)
+		}
+		return nil
+	})
+	return profile, err
+}
+
+func (r *profileRepository) CreateOrUpdateProfile(ctx context.Context, profile *entity.Profile, currentVersion int64) (*entity.Profile, error) {
+	err := r.s.do(func(t *tables) error {
+		return t.saveVersioned(profile, currentVersion)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
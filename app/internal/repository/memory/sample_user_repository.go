@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type sampleUserRepository struct {
+	s *Store
+}
+
+func NewSampleUserRepository(s *Store) repository.SampleUserRepository {
+	return &sampleUserRepository{s: s}
+}
+
+func (r *sampleUserRepository) GetAllSampleUsers(c *gin.Context) ([]entity.SampleUser, error) {
+	// GORMと同じく、レコードがない場合も空のスライスを返す（JSONでnullにしない）
+	users := []entity.SampleUser{}
+	err := r.s.do(func(t *tables) error {
+		users = append(users, clone(t.sampleUsers)...)
+		return nil
+	})
+	return users, err
+}
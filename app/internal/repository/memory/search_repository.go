@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type searchRepository struct {
+	s *Store
+}
+
+func NewSearchRepository(s *Store) repository.SearchRepository {
+	return &searchRepository{s: s}
+}
+
+// Search すべての語を含む値のみを返す（pg_trgmによる表記ゆれの一致は行わない）
+func (r *searchRepository) Search(ctx context.Context, userID uuid.UUID, query string, terms []string, limit int) ([]entity.SearchHit, error) {
+	hits := make([]entity.SearchHit, 0)
+	err := r.s.do(func(t *tables) error {
+		for _, target := range entity.SearchTargets() {
+			model, exists := t.get(target.Table, userID)
+			if !exists {
+				continue
+			}
+
+			// 配列項目（一覧項目を除く）は要素ごとに照合
+			for _, f := range entity.ModelFields(model) {
+				if isListItemField(model, f.Key) {
+					continue
+				}
+				for _, value := range fieldValues(model, f) {
+					if containsAllTerms(value, terms) {
+						hits = append(hits, entity.SearchHit{TargetTable: target.Table, FieldName: f.Key, Content: value, Score: 1})
+					}
+				}
+			}
+
+			// 一覧項目は項目IDとともに照合
+			for _, group := range entity.ModelListGroups(model) {
+				for _, item := range entity.ModelListItems(model)[group.Key] {
+					itemID := item.ID
+					for _, f := range group.Fields {
+						if value := item.Values[f.Key]; containsAllTerms(value, terms) {
+							hits = append(hits, entity.SearchHit{TargetTable: target.Table, FieldName: f.Key, ItemID: &itemID, Content: value, Score: 1})
+						}
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 検索対象の順・項目キーの順（SQLのORDER BYと同じ）
+	order := make(map[string]int)
+	for i, target := range entity.SearchTargets() {
+		order[target.Table] = i
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		if order[hits[i].TargetTable] != order[hits[j].TargetTable] {
+			return order[hits[i].TargetTable] < order[hits[j].TargetTable]
+		}
+		return hits[i].FieldName < hits[j].FieldName
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits, nil
+}
+
+// isListItemField 一覧項目（itemsに保存する配列項目）かどうか
+func isListItemField(model interface{}, key string) bool {
+	if !entity.HasListItems(model) {
+		return false
+	}
+	for _, group := range entity.ModelListGroups(model) {
+		for _, f := range group.Fields {
+			if f.Key == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fieldValues 項目の値（配列項目は要素ごと）
+func fieldValues(model interface{}, f entity.ServiceField) []string {
+	switch value := f.Value(model).(type) {
+	case string:
+		return []string{value}
+	case pq.StringArray:
+		return value
+	default:
+		return nil
+	}
+}
+
+// containsAllTerms 大文字・小文字を区別せずに、すべての語を含むかどうか
+func containsAllTerms(value string, terms []string) bool {
+	if value == "" {
+		return false
+	}
+	lower := strings.ToLower(value)
+	for _, term := range terms {
+		if !strings.Contains(lower, strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type serviceRepository struct {
+	s   *Store
+	def entity.ServiceDefinition
+}
+
+func NewServiceRepository(s *Store, def entity.ServiceDefinition) repository.ServiceRepository {
+	return &serviceRepository{s: s, def: def}
+}
+
+func (r *serviceRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	var model interface{}
+	err := r.s.do(func(t *tables) error {
+		if row, exists := t.get(tableName(r.def.NewModel()), userID); exists {
+			model = row
+		}
+		return nil
+	})
+	return model, err // レコードが見つからない場合はnilを返す
+}
+
+func (r *serviceRepository) CreateOrUpdate(ctx context.Context, model interface{}, currentVersion int64) (interface{}, error) {
+	err := r.s.do(func(t *tables) error {
+		return t.saveVersioned(model, currentVersion)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
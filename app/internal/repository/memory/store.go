@@ -0,0 +1,292 @@
+// Package memory DBを使わずにサーバーを動かすための、メモリ上のリポジトリ
+// DATA_STORE=memoryで起動した場合やユースケースのテストで使用し、プロセスを終了するとデータは消える
+package memory
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// Store 全テーブルのデータ（1つのロックで直列に読み書きする）
+type Store struct {
+	mu   *sync.Mutex
+	data *tables
+	inTx bool // UnitOfWork.Doの中ではロック済みのため、ロックを取らない
+}
+
+// tables テーブルごとのレコード
+type tables struct {
+	sampleUsers    []entity.SampleUser
+	rows           map[string]map[uuid.UUID]interface{} // バージョンを持つテーブル（users・profiles・各サービス）
+	customServices map[uuid.UUID]*entity.CustomService
+	offerBoxPhotos map[photoKey]*entity.OfferBoxPhoto
+	logs           map[logKey]*entity.Log
+	fieldRevisions []*entity.FieldRevision
+	aiHistories    []*entity.AIGenerationHistory
+	auditEvents    []*entity.AuditEvent
+}
+
+type photoKey struct {
+	userID uuid.UUID
+	slot   int
+}
+
+type logKey struct {
+	userID      uuid.UUID
+	targetTable string
+	fieldName   string
+}
+
+func NewStore() *Store {
+	return &Store{
+		mu: &sync.Mutex{},
+		data: &tables{
+			rows:           make(map[string]map[uuid.UUID]interface{}),
+			customServices: make(map[uuid.UUID]*entity.CustomService),
+			offerBoxPhotos: make(map[photoKey]*entity.OfferBoxPhoto),
+			logs:           make(map[logKey]*entity.Log),
+		},
+	}
+}
+
+// NewRepositories メモリ上のリポジトリ一式を生成（全リポジトリで1つのStoreを共有する）
+func NewRepositories() *repository.Repositories {
+	s := NewStore()
+	services := make(map[string]repository.ServiceRepository, len(entity.Services))
+	for _, def := range entity.Services {
+		services[def.Key] = NewServiceRepository(s, def)
+	}
+
+	return &repository.Repositories{
+		SampleUsers:    NewSampleUserRepository(s),
+		Users:          NewUserRepository(s),
+		Profiles:       NewProfileRepository(s),
+		Services:       services,
+		CustomServices: NewCustomServiceRepository(s),
+		OfferBoxPhotos: NewOfferBoxPhotoRepository(s),
+		Logs:           NewLogRepository(s),
+		FieldRevisions: NewFieldRevisionRepository(s),
+		AIGeneration:   NewAIGenerationRepository(s),
+		Export:         NewExportRepository(s),
+		Import:         NewImportRepository(s),
+		Search:         NewSearchRepository(s),
+		Audit:          NewAuditRepository(s),
+		Health:         NewHealthRepository(),
+		UnitOfWork:     NewUnitOfWork(s),
+	}
+}
+
+// do ロックを取って読み書き
+func (s *Store) do(fn func(t *tables) error) error {
+	if s.inTx {
+		return fn(s.data)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.data)
+}
+
+// atomic ロックを取って読み書きし、エラー（panicを含む）の場合は実行前の状態に戻す
+// UnitOfWork.Doの中では、ロールバックをDoに任せる
+func (s *Store) atomic(fn func(t *tables) error) error {
+	if s.inTx {
+		return fn(s.data)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := s.data.snapshot()
+	defer func() {
+		if r := recover(); r != nil {
+			*s.data = *snapshot
+			panic(r)
+		}
+	}()
+	if err := fn(s.data); err != nil {
+		*s.data = *snapshot
+		return err
+	}
+	return nil
+}
+
+// snapshot 全テーブルの複製（ロールバック用）
+func (t *tables) snapshot() *tables {
+	return &tables{
+		sampleUsers:    clone(t.sampleUsers),
+		rows:           clone(t.rows),
+		customServices: clone(t.customServices),
+		offerBoxPhotos: clone(t.offerBoxPhotos),
+		logs:           clone(t.logs),
+		fieldRevisions: clone(t.fieldRevisions),
+		aiHistories:    clone(t.aiHistories),
+		auditEvents:    clone(t.auditEvents),
+	}
+}
+
+// txView ロック済みのStore（UnitOfWork.Doの中で使う）
+func (s *Store) txView() *Store {
+	return &Store{mu: s.mu, data: s.data, inTx: true}
+}
+
+// get バージョンを持つテーブルのレコードの複製を取得（一覧項目から配列項目を設定）
+func (t *tables) get(table string, id uuid.UUID) (interface{}, bool) {
+	row, exists := t.rows[table][id]
+	if !exists {
+		return nil, false
+	}
+	model := clone(row)
+	entity.UnpackListItems(model)
+	return model, true
+}
+
+// saveVersioned 楽観的ロックでレコードを保存し、バージョンを1つ進める（repository.saveVersionedと同じ動作）
+func (t *tables) saveVersioned(model interface{}, currentVersion int64) error {
+	if err := entity.PackListItems(model); err != nil {
+		return err
+	}
+
+	table, id := tableName(model), modelID(model)
+	current, exists := t.rows[table][id]
+	if currentVersion == 0 && exists {
+		return fmt.Errorf("%w: record was created concurrently", entity.ErrVersionConflict)
+	}
+	if currentVersion != 0 && (!exists || entity.ModelVersion(current) != currentVersion) {
+		return fmt.Errorf("%w: record was updated concurrently", entity.ErrVersionConflict)
+	}
+
+	entity.SetModelVersion(model, currentVersion+1)
+	touchTimestamps(model, !exists)
+	t.put(model)
+	return nil
+}
+
+// put レコードの複製を保存
+func (t *tables) put(model interface{}) {
+	table := tableName(model)
+	if t.rows[table] == nil {
+		t.rows[table] = make(map[uuid.UUID]interface{})
+	}
+	t.rows[table][modelID(model)] = clone(model)
+}
+
+// delete レコードを削除し、削除件数を返す
+func (t *tables) delete(table string, id uuid.UUID) int64 {
+	if _, exists := t.rows[table][id]; !exists {
+		return 0
+	}
+	delete(t.rows[table], id)
+	return 1
+}
+
+// logsOf ユーザーのログを更新日時の順に取得
+func (t *tables) logsOf(userID uuid.UUID) []entity.Log {
+	logs := make([]entity.Log, 0)
+	for key, l := range t.logs {
+		if key.userID == userID {
+			logs = append(logs, *l)
+		}
+	}
+	sort.Slice(logs, func(i, j int) bool { return logs[i].UpdatedAt.Before(logs[j].UpdatedAt) })
+	return logs
+}
+
+// upsertLogs 各フィールドのログを作成し、既存の場合はupdated_atのみ更新
+func (t *tables) upsertLogs(userID uuid.UUID, targetTable string, fieldNames []string) {
+	now := time.Now().In(repository.JST)
+	for _, fieldName := range fieldNames {
+		key := logKey{userID: userID, targetTable: targetTable, fieldName: fieldName}
+		if l, exists := t.logs[key]; exists {
+			l.UpdatedAt = now
+			continue
+		}
+		t.logs[key] = &entity.Log{ID: uuid.New(), UserID: userID, TargetTable: targetTable, FieldName: fieldName, UpdatedAt: now}
+	}
+}
+
+// tableName エンティティのテーブル名
+func tableName(model interface{}) string {
+	return model.(interface{ TableName() string }).TableName()
+}
+
+// modelID エンティティの主キー（usersはuser_id、それ以外はid）
+func modelID(model interface{}) uuid.UUID {
+	v := reflect.ValueOf(model).Elem()
+	if f := v.FieldByName("ID"); f.IsValid() {
+		return f.Interface().(uuid.UUID)
+	}
+	return v.FieldByName("UserID").Interface().(uuid.UUID)
+}
+
+// touchTimestamps GORMと同様に作成日時（未設定の場合）と更新日時を設定
+func touchTimestamps(model interface{}, creating bool) {
+	now := time.Now()
+	v := reflect.ValueOf(model).Elem()
+	if f := v.FieldByName("CreatedAt"); creating && f.IsValid() && f.Interface().(time.Time).IsZero() {
+		f.Set(reflect.ValueOf(now))
+	}
+	if f := v.FieldByName("UpdatedAt"); f.IsValid() {
+		f.Set(reflect.ValueOf(now))
+	}
+}
+
+// clone 値を再帰的に複製（保存したレコードが呼び出し元の変更の影響を受けないようにする）
+func clone[T any](v T) T {
+	return cloneValue(reflect.ValueOf(&v).Elem()).Interface().(T)
+}
+
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(cloneValue(v.Elem()))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(cloneValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,70 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+type unitOfWork struct {
+	s *Store
+}
+
+func NewUnitOfWork(s *Store) repository.UnitOfWork {
+	return &unitOfWork{s: s}
+}
+
+// Do fnの実行中はロックを保持し、エラー（panicを含む）の場合は実行前の状態に戻す
+func (u *unitOfWork) Do(ctx context.Context, fn func(tx repository.Tx) error) error {
+	return u.s.atomic(func(t *tables) error {
+		return fn(&unitOfWorkTx{s: u.s.txView()})
+	})
+}
+
+// unitOfWorkTx ロック済みのStoreを共有するリポジトリを生成
+type unitOfWorkTx struct {
+	s *Store
+}
+
+func (t *unitOfWorkTx) Users() repository.UserRepository {
+	return NewUserRepository(t.s)
+}
+
+func (t *unitOfWorkTx) Services(def entity.ServiceDefinition) repository.ServiceRepository {
+	return NewServiceRepository(t.s, def)
+}
+
+func (t *unitOfWorkTx) Profiles() repository.ProfileRepository {
+	return NewProfileRepository(t.s)
+}
+
+func (t *unitOfWorkTx) CustomServices() repository.CustomServiceRepository {
+	return NewCustomServiceRepository(t.s)
+}
+
+func (t *unitOfWorkTx) OfferBoxPhotos() repository.OfferBoxPhotoRepository {
+	return NewOfferBoxPhotoRepository(t.s)
+}
+
+func (t *unitOfWorkTx) Logs() repository.LogRepository {
+	return NewLogRepository(t.s)
+}
+
+func (t *unitOfWorkTx) FieldRevisions() repository.FieldRevisionRepository {
+	return NewFieldRevisionRepository(t.s)
+}
+
+func (t *unitOfWorkTx) RecordChanges(ctx context.Context, userID uuid.UUID, targetTable, source string, before, after interface{}, fieldNames []string) error {
+	revisions := entity.DiffFieldRevisions(userID, targetTable, source, before, after)
+	if err := t.FieldRevisions().CreateRevisions(ctx, revisions); err != nil {
+		return fmt.Errorf("failed to record field revisions: %w", err)
+	}
+	if err := t.Logs().UpsertLogs(ctx, userID, targetTable, fieldNames); err != nil {
+		return fmt.Errorf("failed to update logs: %w", err)
+	}
+	return nil
+}
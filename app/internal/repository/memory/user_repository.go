@@ -0,0 +1,229 @@
+package memory
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+var usersTable = entity.User{}.TableName()
+
+type userRepository struct {
+	s *Store
+}
+
+func NewUserRepository(s *Store) repository.UserRepository {
+	return &userRepository{s: s}
+}
+
+func (r *userRepository) GetUserByID(c *gin.Context, userID string) (*entity.User, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	var user *entity.User
+	err = r.s.do(func(t *tables) error {
+		row, exists := t.get(usersTable, id)
+		if !exists {
+			return gorm.ErrRecordNotFound
+		}
+		user = row.(*entity.User)
+		return nil
+	})
+	return user, err
+}
+
+func (r *userRepository) UpdateUserServices(c *gin.Context, userID string, services []string, expectedVersion *int64) (*entity.User, error) {
+	var user *entity.User
+	err := r.s.atomic(func(t *tables) error {
+		existing, err := findUser(t, userID)
+		if err != nil {
+			return err
+		}
+		if err := entity.CheckVersion(expectedVersion, existing); err != nil {
+			return err
+		}
+		existing.Services = services
+		user = existing
+		return t.saveVersioned(user, user.Version)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *userRepository) CreateUser(c *gin.Context, user *entity.User) error {
+	return r.s.do(func(t *tables) error {
+		if _, exists := t.rows[usersTable][user.UserID]; exists {
+			return fmt.Errorf("duplicate key value violates unique constraint: user_id %s", user.UserID)
+		}
+		// カラムの既定値（version = 1）と作成日時・更新日時を設定
+		if user.Version == 0 {
+			user.Version = 1
+		}
+		touchTimestamps(user, true)
+		t.put(user)
+		return nil
+	})
+}
+
+func (r *userRepository) UpdateUser(c *gin.Context, userID string, updateData map[string]interface{}, expectedVersion *int64) (*entity.User, error) {
+	var user *entity.User
+	err := r.s.atomic(func(t *tables) error {
+		existing, err := findUser(t, userID)
+		if err != nil {
+			return err
+		}
+		if err := entity.CheckVersion(expectedVersion, existing); err != nil {
+			return err
+		}
+
+		// カラム名（JSONキーと同じ）ごとに値を設定
+		for column, value := range updateData {
+			if err := setColumn(existing, column, value); err != nil {
+				return err
+			}
+		}
+		existing.UpdatedAt = time.Now()
+		existing.Version++
+		t.put(existing)
+		user = existing
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *userRepository) SaveUser(c *gin.Context, user *entity.User, currentVersion int64) error {
+	return r.s.do(func(t *tables) error {
+		return t.saveVersioned(user, currentVersion)
+	})
+}
+
+func (r *userRepository) DeleteUser(c *gin.Context, userID uuid.UUID) (*entity.UserDeletionReceipt, error) {
+	receipt := &entity.UserDeletionReceipt{
+		UserID:         userID,
+		DeletedRecords: make(map[string]int64),
+	}
+	count := func(table string, n int64) {
+		receipt.DeletedRecords[table] = n
+		receipt.TotalRecords += n
+	}
+
+	found := false
+	err := r.s.atomic(func(t *tables) error {
+		if _, exists := t.rows[usersTable][userID]; !exists {
+			return nil
+		}
+		found = true
+
+		var n int64
+		for key := range t.logs {
+			if key.userID == userID {
+				delete(t.logs, key)
+				n++
+			}
+		}
+		count(entity.Log{}.TableName(), n)
+
+		var removed int
+		t.aiHistories, removed = removeWhere(t.aiHistories, func(h *entity.AIGenerationHistory) bool { return h.UserID == userID })
+		count(entity.AIGenerationHistory{}.TableName(), int64(removed))
+		t.fieldRevisions, removed = removeWhere(t.fieldRevisions, func(fr *entity.FieldRevision) bool { return fr.UserID == userID })
+		count(entity.FieldRevision{}.TableName(), int64(removed))
+		t.auditEvents, removed = removeWhere(t.auditEvents, func(e *entity.AuditEvent) bool { return e.UserID == userID })
+		count(entity.AuditEvent{}.TableName(), int64(removed))
+
+		n = 0
+		for id, cs := range t.customServices {
+			if cs.UserID == userID {
+				delete(t.customServices, id)
+				n++
+			}
+		}
+		count(entity.CustomService{}.TableName(), n)
+
+		n = 0
+		for key := range t.offerBoxPhotos {
+			if key.userID == userID {
+				delete(t.offerBoxPhotos, key)
+				n++
+			}
+		}
+		count(entity.OfferBoxPhoto{}.TableName(), n)
+
+		count(entity.Profile{}.TableName(), t.delete(entity.Profile{}.TableName(), userID))
+		for _, def := range entity.Services {
+			table := tableName(def.NewModel())
+			count(table, t.delete(table, userID))
+		}
+		count(usersTable, t.delete(usersTable, userID))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil // ユーザーが見つからない場合はnilを返す
+	}
+
+	receipt.DeletedAt = time.Now()
+	return receipt, nil
+}
+
+// findUser 文字列のユーザーIDでユーザーを取得（見つからない場合はgorm.ErrRecordNotFound）
+func findUser(t *tables, userID string) (*entity.User, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+	row, exists := t.get(usersTable, id)
+	if !exists {
+		return nil, fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)
+	}
+	return row.(*entity.User), nil
+}
+
+// setColumn JSONキーが一致するフィールドに値を設定
+func setColumn(model interface{}, column string, value interface{}) error {
+	v := reflect.ValueOf(model).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0] != column {
+			continue
+		}
+		field := v.Field(i)
+		if value == nil {
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		rv := reflect.ValueOf(value)
+		if !rv.Type().ConvertibleTo(field.Type()) {
+			return fmt.Errorf("cannot set %s to %T", column, value)
+		}
+		field.Set(rv.Convert(field.Type()))
+		return nil
+	}
+	return fmt.Errorf("unknown column: %s", column)
+}
+
+// removeWhere 条件に一致する要素を除き、除いた件数を返す
+func removeWhere[T any](items []T, match func(T) bool) ([]T, int) {
+	kept := items[:0]
+	for _, item := range items {
+		if !match(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept, len(items) - len(kept)
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+type OfferBoxPhotoRepository interface {
+	GetPhotosByUserID(ctx context.Context, userID uuid.UUID) ([]entity.OfferBoxPhoto, error)
+	GetPhoto(ctx context.Context, userID uuid.UUID, slot int) (*entity.OfferBoxPhoto, error)
+	GetPhotoCaptions(ctx context.Context, userID uuid.UUID) ([]string, error)
+	SavePhoto(ctx context.Context, photo *entity.OfferBoxPhoto) (*entity.OfferBoxPhoto, error)
+	DeletePhoto(ctx context.Context, userID uuid.UUID, slot int) error
+}
+
+type offerBoxPhotoRepository struct {
+	db *gorm.DB
+}
+
+func NewOfferBoxPhotoRepository(db *gorm.DB) OfferBoxPhotoRepository {
+	return &offerBoxPhotoRepository{db: db}
+}
+
+func (r *offerBoxPhotoRepository) GetPhotosByUserID(ctx context.Context, userID uuid.UUID) ([]entity.OfferBoxPhoto, error) {
+	var photos []entity.OfferBoxPhoto
+	// 一覧では画像データ本体は読み込まない
+	result := r.db.WithContext(ctx).Omit("data").Where("user_id = ?", userID).Order("slot").Find(&photos)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return photos, nil
+}
+
+func (r *offerBoxPhotoRepository) GetPhoto(ctx context.Context, userID uuid.UUID, slot int) (*entity.OfferBoxPhoto, error) {
+	var photo entity.OfferBoxPhoto
+	result := r.db.WithContext(ctx).Where("user_id = ? AND slot = ?", userID, slot).First(&photo)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil // レコードが見つからない場合はnilを返す
+		}
+		return nil, result.Error
+	}
+	return &photo, nil
+}
+
+func (r *offerBoxPhotoRepository) GetPhotoCaptions(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	var offerBox entity.OfferBox
+	result := r.db.WithContext(ctx).Select("photo_captions").Where("id = ?", userID).First(&offerBox)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil // OfferBoxが未登録の場合はキャプションなし
+		}
+		return nil, result.Error
+	}
+	return offerBox.PhotoCaptions, nil
+}
+
+func (r *offerBoxPhotoRepository) SavePhoto(ctx context.Context, photo *entity.OfferBoxPhoto) (*entity.OfferBoxPhoto, error) {
+	// user_idとslotの複合主キーでupsert
+	result := r.db.WithContext(ctx).Save(photo)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return photo, nil
+}
+
+func (r *offerBoxPhotoRepository) DeletePhoto(ctx context.Context, userID uuid.UUID, slot int) error {
+	return r.db.WithContext(ctx).Where("user_id = ? AND slot = ?", userID, slot).Delete(&entity.OfferBoxPhoto{}).Error
+}
@@ -1,7 +1,8 @@
 package repository
 
 import (
-	"github.com/gin-gonic/gin"
+	"context"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 
@@ -9,8 +10,9 @@ import (
 )
 
 type ProfileRepository interface {
-	GetProfileByUserID(c *gin.Context, userID uuid.UUID) (*entity.Profile, error)
-	CreateOrUpdateProfile(c *gin.Context, profile *entity.Profile) (*entity.Profile, error)
+	GetProfileByUserID(ctx context.Context, userID uuid.UUID) (*entity.Profile, error)
+	// currentVersionは保存の前提として読み込んだバージョン（レコードが存在しない場合は0）
+	CreateOrUpdateProfile(ctx context.Context, profile *entity.Profile, currentVersion int64) (*entity.Profile, error)
 }
 
 type profileRepository struct {
@@ -23,9 +25,9 @@ func NewProfileRepository(db *gorm.DB) ProfileRepository {
 }
 
 // 指定されたユーザーIDに紐づくProfileを取得
-func (r *profileRepository) GetProfileByUserID(c *gin.Context, userID uuid.UUID) (*entity.Profile, error) {
+func (r *profileRepository) GetProfileByUserID(ctx context.Context, userID uuid.UUID) (*entity.Profile, error) {
 	var profile entity.Profile
-	result := r.db.Where("id = ?", userID).First(&profile)
+	result := r.db.WithContext(ctx).Where("id = ?", userID).First(&profile)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -35,11 +37,10 @@ func (r *profileRepository) GetProfileByUserID(c *gin.Context, userID uuid.UUID)
 	return &profile, nil
 }
 
-// Profileレコードを作成または更新
-func (r *profileRepository) CreateOrUpdateProfile(c *gin.Context, profile *entity.Profile) (*entity.Profile, error) {
-	result := r.db.Save(profile)
-	if result.Error != nil {
-		return nil, result.Error
+// Profileレコードを作成または更新（バージョンが一致する場合のみ）
+func (r *profileRepository) CreateOrUpdateProfile(ctx context.Context, profile *entity.Profile, currentVersion int64) (*entity.Profile, error) {
+	if err := saveVersioned(r.db.WithContext(ctx), profile, currentVersion); err != nil {
+		return nil, err
 	}
 	return profile, nil
 }
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// Repositories サーバーが使うリポジトリ一式
+// データストア（PostgreSQL・メモリ）ごとに生成し、main.goでユースケースに渡す
+type Repositories struct {
+	SampleUsers    SampleUserRepository
+	Users          UserRepository
+	Profiles       ProfileRepository
+	Services       map[string]ServiceRepository // サービスキー → リポジトリ
+	CustomServices CustomServiceRepository
+	OfferBoxPhotos OfferBoxPhotoRepository
+	Logs           LogRepository
+	FieldRevisions FieldRevisionRepository
+	AIGeneration   AIGenerationRepository
+	Export         ExportRepository
+	Import         ImportRepository
+	Search         SearchRepository
+	Audit          AuditRepository
+	Health         HealthRepository
+	UnitOfWork     UnitOfWork
+}
+
+// NewRepositories PostgreSQL（GORM）のリポジトリ一式を生成
+func NewRepositories(db *gorm.DB) *Repositories {
+	services := make(map[string]ServiceRepository, len(entity.Services))
+	for _, def := range entity.Services {
+		services[def.Key] = NewServiceRepository(db, def)
+	}
+
+	return &Repositories{
+		SampleUsers:    NewSampleUserRepository(db),
+		Users:          NewUserRepository(db),
+		Profiles:       NewProfileRepository(db),
+		Services:       services,
+		CustomServices: NewCustomServiceRepository(db),
+		OfferBoxPhotos: NewOfferBoxPhotoRepository(db),
+		Logs:           NewLogRepository(db),
+		FieldRevisions: NewFieldRevisionRepository(db),
+		AIGeneration:   NewAIGenerationRepository(db),
+		Export:         NewExportRepository(db),
+		Import:         NewImportRepository(db),
+		Search:         NewSearchRepository(db),
+		Audit:          NewAuditRepository(db),
+		Health:         NewHealthRepository(db),
+		UnitOfWork:     NewUnitOfWork(db),
+	}
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+type SearchRepository interface {
+	// ESと全サービスの文字列項目・配列項目・一覧項目から、すべての語を含む値または表記の近い値を関連度順に取得
+	Search(ctx context.Context, userID uuid.UUID, query string, terms []string, limit int) ([]entity.SearchHit, error)
+}
+
+type searchRepository struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) SearchRepository {
+	return &searchRepository{db: db}
+}
+
+// 検索対象の値を取り出すSQL（テーブル名はレジストリの定義のみを埋め込む）
+// id・version・itemsを除いた列を値ごとの行に展開し、配列項目は要素ごと、一覧項目は項目IDとともに展開する
+const (
+	searchColumnsSQL = `SELECT %[1]d AS target_order, '%[2]s' AS target_table, f.key AS field_name, NULL::uuid AS item_id, v.content
+FROM "%[2]s" AS t
+CROSS JOIN LATERAL jsonb_each(to_jsonb(t) - 'id' - 'version' - 'items') AS f
+CROSS JOIN LATERAL (
+    SELECT f.value #>> '{}' AS content WHERE jsonb_typeof(f.value) = 'string'
+    UNION ALL
+    SELECT e FROM jsonb_array_elements_text(CASE WHEN jsonb_typeof(f.value) = 'array' THEN f.value ELSE '[]'::jsonb END) AS e
+) AS v
+WHERE t.id = @user_id`
+
+	searchListItemsSQL = `SELECT %[1]d AS target_order, '%[2]s' AS target_table, kv.key AS field_name, (item->>'id')::uuid AS item_id, kv.value AS content
+FROM "%[2]s" AS t
+CROSS JOIN LATERAL jsonb_each(t.items) AS g
+CROSS JOIN LATERAL jsonb_array_elements(g.value) AS item
+CROSS JOIN LATERAL jsonb_each_text(item - 'id') AS kv
+WHERE t.id = @user_id`
+)
+
+// Search 部分一致（ILIKE）を優先し、表記ゆれはpg_trgmの単語類似度（<%）で拾う
+// 日本語は形態素解析なしでも文字単位のトライグラムで照合できる
+func (r *searchRepository) Search(ctx context.Context, userID uuid.UUID, query string, terms []string, limit int) ([]entity.SearchHit, error) {
+	var sources []string
+	for i, target := range entity.SearchTargets() {
+		sources = append(sources, fmt.Sprintf(searchColumnsSQL, i, target.Table))
+		if entity.HasListItems(target.Model) {
+			sources = append(sources, fmt.Sprintf(searchListItemsSQL, i, target.Table))
+		}
+	}
+
+	sql := `SELECT target_table, field_name, item_id, content,
+    CASE WHEN content ILIKE ALL(CAST(@patterns AS text[])) THEN 1 ELSE word_similarity(@query, content) END AS score
+FROM (` + strings.Join(sources, "\nUNION ALL\n") + `) AS fields
+WHERE content <> '' AND (content ILIKE ALL(CAST(@patterns AS text[])) OR @query <% content)
+ORDER BY score DESC, target_order, field_name
+LIMIT @limit`
+
+	patterns := make(pq.StringArray, 0, len(terms))
+	for _, term := range terms {
+		patterns = append(patterns, "%"+escapeLikePattern(term)+"%")
+	}
+
+	var hits []entity.SearchHit
+	err := r.db.WithContext(ctx).Raw(sql, map[string]interface{}{
+		"user_id":  userID,
+		"query":    query,
+		"patterns": patterns,
+		"limit":    limit,
+	}).Scan(&hits).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to search profiles: %w", err)
+	}
+	return hits, nil
+}
+
+// escapeLikePattern LIKEのワイルドカード（%・_）とエスケープ文字を通常の文字として扱う
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
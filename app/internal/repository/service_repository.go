@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// ServiceRepository 就活サービス（サポーターズ、マイナビ等）のデータアクセス
+// サービスごとの差分はentity.ServiceDefinitionで吸収する
+type ServiceRepository interface {
+	GetByUserID(ctx context.Context, userID uuid.UUID) (interface{}, error)
+	// currentVersionは保存の前提として読み込んだバージョン（レコードが存在しない場合は0）
+	CreateOrUpdate(ctx context.Context, model interface{}, currentVersion int64) (interface{}, error)
+}
+
+type serviceRepository struct {
+	db  *gorm.DB
+	def entity.ServiceDefinition
+}
+
+func NewServiceRepository(db *gorm.DB, def entity.ServiceDefinition) ServiceRepository {
+	return &serviceRepository{db: db, def: def}
+}
+
+func (r *serviceRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (interface{}, error) {
+	model := r.def.NewModel()
+	result := r.db.WithContext(ctx).Where("id = ?", userID).First(model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil // レコードが見つからない場合はnilを返す
+		}
+		return nil, result.Error
+	}
+	return model, nil
+}
+
+func (r *serviceRepository) CreateOrUpdate(ctx context.Context, model interface{}, currentVersion int64) (interface{}, error) {
+	// バージョンが一致する場合のみupsert
+	if err := saveVersioned(r.db.WithContext(ctx), model, currentVersion); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
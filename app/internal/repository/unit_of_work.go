@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// UnitOfWork 複数のリポジトリへの書き込みを1つのトランザクションにまとめる
+// フォーム・PATCHなどの手動の保存とAI生成の保存で共通して使い、データとログ・変更履歴が食い違わないようにする
+type UnitOfWork interface {
+	// fnがエラーを返した場合（panicを含む）はfn内のすべての書き込みをロールバックする
+	Do(ctx context.Context, fn func(tx Tx) error) error
+}
+
+// Tx トランザクション内で使うリポジトリ
+type Tx interface {
+	Users() UserRepository
+	Services(def entity.ServiceDefinition) ServiceRepository
+	Profiles() ProfileRepository
+	CustomServices() CustomServiceRepository
+	OfferBoxPhotos() OfferBoxPhotoRepository
+	Logs() LogRepository
+	FieldRevisions() FieldRevisionRepository
+	// 保存前後の値から変更履歴を記録し、変更したフィールドのログを更新
+	RecordChanges(ctx context.Context, userID uuid.UUID, targetTable, source string, before, after interface{}, fieldNames []string) error
+}
+
+type unitOfWork struct {
+	db *gorm.DB
+}
+
+func NewUnitOfWork(db *gorm.DB) UnitOfWork {
+	return &unitOfWork{db: db}
+}
+
+func (u *unitOfWork) Do(ctx context.Context, fn func(tx Tx) error) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&unitOfWorkTx{db: tx})
+	})
+}
+
+// unitOfWorkTx トランザクションを共有するリポジトリを生成
+type unitOfWorkTx struct {
+	db *gorm.DB
+}
+
+func (t *unitOfWorkTx) Users() UserRepository {
+	return NewUserRepository(t.db)
+}
+
+func (t *unitOfWorkTx) Services(def entity.ServiceDefinition) ServiceRepository {
+	return NewServiceRepository(t.db, def)
+}
+
+func (t *unitOfWorkTx) Profiles() ProfileRepository {
+	return NewProfileRepository(t.db)
+}
+
+func (t *unitOfWorkTx) CustomServices() CustomServiceRepository {
+	return NewCustomServiceRepository(t.db)
+}
+
+func (t *unitOfWorkTx) OfferBoxPhotos() OfferBoxPhotoRepository {
+	return NewOfferBoxPhotoRepository(t.db)
+}
+
+func (t *unitOfWorkTx) Logs() LogRepository {
+	return NewLogRepository(t.db)
+}
+
+func (t *unitOfWorkTx) FieldRevisions() FieldRevisionRepository {
+	return NewFieldRevisionRepository(t.db)
+}
+
+func (t *unitOfWorkTx) RecordChanges(ctx context.Context, userID uuid.UUID, targetTable, source string, before, after interface{}, fieldNames []string) error {
+	revisions := entity.DiffFieldRevisions(userID, targetTable, source, before, after)
+	if err := t.FieldRevisions().CreateRevisions(ctx, revisions); err != nil {
+		return fmt.Errorf("failed to record field revisions: %w", err)
+	}
+	if err := t.Logs().UpsertLogs(ctx, userID, targetTable, fieldNames); err != nil {
+		return fmt.Errorf("failed to update logs: %w", err)
+	}
+	return nil
+}
@@ -1,10 +1,12 @@
 package repository
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"job-hunting-service-management-backend/app/internal/entity"
@@ -13,10 +15,15 @@ import (
 type UserRepository interface {
 	GetUserByID(c *gin.Context, userID string) (*entity.User, error)
 	//ユーザーIDとサービスリストを基に既存ユーザーの情報を更新
-	UpdateUserServices(c *gin.Context, userID string, services []string) error
+	//expectedVersionはIf-Matchで指定されたバージョン（nilの場合は無条件）
+	UpdateUserServices(c *gin.Context, userID string, services []string, expectedVersion *int64) (*entity.User, error)
 	//新しいユーザー情報をデータベースに保存
 	CreateUser(c *gin.Context, user *entity.User) error
-	UpdateUser(c *gin.Context, userID string, updateData map[string]interface{}) (*entity.User, error)
+	UpdateUser(c *gin.Context, userID string, updateData map[string]interface{}, expectedVersion *int64) (*entity.User, error)
+	//ユーザーレコード全体を保存（currentVersionは読み込んだ時点のバージョン）
+	SaveUser(c *gin.Context, user *entity.User, currentVersion int64) error
+	//ユーザーと関連する全てのデータを1トランザクションで削除
+	DeleteUser(c *gin.Context, userID uuid.UUID) (*entity.UserDeletionReceipt, error)
 }
 
 type userRepository struct {
@@ -27,19 +34,31 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{db: db}
 }
 
-func (r *userRepository) UpdateUserServices(c *gin.Context, userID string, services []string) error {
+func (r *userRepository) UpdateUserServices(c *gin.Context, userID string, services []string, expectedVersion *int64) (*entity.User, error) {
 	var user entity.User
-	// ユーザーIDでユーザーを検索
-	if err := r.db.Where("user_id = ?", userID).First(&user).Error; err != nil {
-		return fmt.Errorf("user not found: %w", err)
-	}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// ユーザーIDでユーザーを検索（更新が終わるまで行ロック）
+		exists, err := lockForUpdate(tx, &user, "user_id = ?", userID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)
+		}
+		if err := entity.CheckVersion(expectedVersion, &user); err != nil {
+			return err
+		}
 
-	// servicesフィールドを更新
-	user.Services = services
+		// servicesフィールドを更新
+		user.Services = services
 
-	// ユーザーレコード全体を保存
-	result := r.db.Save(&user)
-	return result.Error
+		// ユーザーレコード全体を保存
+		return saveVersioned(tx, &user, user.Version)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
 }
 
 func (r *userRepository) CreateUser(c *gin.Context, user *entity.User) error {
@@ -48,23 +67,30 @@ func (r *userRepository) CreateUser(c *gin.Context, user *entity.User) error {
 	return result.Error
 }
 
-func (r *userRepository) UpdateUser(c *gin.Context, userID string, updateData map[string]interface{}) (*entity.User, error) {
-	// user_idで既存レコードを検索
-	var existingUser entity.User
-	result := r.db.Where("user_id = ?", userID).First(&existingUser)
-	if result.Error != nil {
-		// レコードが見つからない場合はエラーを返す
-		if result.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found: %w", result.Error)
+func (r *userRepository) UpdateUser(c *gin.Context, userID string, updateData map[string]interface{}, expectedVersion *int64) (*entity.User, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// user_idで既存レコードを検索（更新が終わるまで行ロック）
+		var existingUser entity.User
+		exists, err := lockForUpdate(tx, &existingUser, "user_id = ?", userID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			// レコードが見つからない場合はエラーを返す
+			return fmt.Errorf("user not found: %w", gorm.ErrRecordNotFound)
+		}
+		if err := entity.CheckVersion(expectedVersion, &existingUser); err != nil {
+			return err
 		}
-		return nil, result.Error
-	}
 
-	// 更新日時を追加
-	updateData["updated_at"] = time.Now()
+		// 更新日時とバージョンを追加
+		updateData["updated_at"] = time.Now()
+		updateData["version"] = gorm.Expr("version + 1")
 
-	// レコードが存在する場合のみ更新を実行
-	if err := r.db.Model(&existingUser).Updates(updateData).Error; err != nil {
+		// レコードが存在する場合のみ更新を実行
+		return tx.Model(&existingUser).Where("version = ?", existingUser.Version).Updates(updateData).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -77,6 +103,11 @@ func (r *userRepository) UpdateUser(c *gin.Context, userID string, updateData ma
 	return &updatedUser, nil
 }
 
+func (r *userRepository) SaveUser(c *gin.Context, user *entity.User, currentVersion int64) error {
+	// 読み込み後に他の書き込みでバージョンが進んでいた場合はErrVersionConflict
+	return saveVersioned(r.db.WithContext(c), user, currentVersion)
+}
+
 func (r *userRepository) GetUserByID(c *gin.Context, userID string) (*entity.User, error) {
 	var user entity.User
 	result := r.db.First(&user, "user_id = ?", userID)
@@ -85,3 +116,68 @@ func (r *userRepository) GetUserByID(c *gin.Context, userID string) (*entity.Use
 	}
 	return &user, nil
 }
+
+// userOwnedTable ユーザーに紐づくテーブルのモデルとユーザーIDのカラム
+type userOwnedTable struct {
+	model  interface{}
+	column string
+}
+
+func (r *userRepository) DeleteUser(c *gin.Context, userID uuid.UUID) (*entity.UserDeletionReceipt, error) {
+	receipt := &entity.UserDeletionReceipt{
+		UserID:         userID,
+		DeletedRecords: make(map[string]int64),
+	}
+
+	// 削除対象のテーブルとユーザーIDのカラム
+	// 外部キーのON DELETE CASCADEでも削除されるが、削除件数を記録するため明示的に削除する
+	targets := []userOwnedTable{
+		{&entity.Log{}, "user_id"},
+		{&entity.AIGenerationHistory{}, "user_id"},
+		{&entity.FieldRevision{}, "user_id"},
+		{&entity.AuditEvent{}, "user_id"},
+		{&entity.CustomService{}, "user_id"},
+		{&entity.OfferBoxPhoto{}, "user_id"},
+		{&entity.Profile{}, "id"},
+	}
+	for _, def := range entity.Services {
+		targets = append(targets, userOwnedTable{def.NewModel(), "id"})
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var user entity.User
+		if err := tx.Where("user_id = ?", userID).First(&user).Error; err != nil {
+			return err
+		}
+
+		for _, target := range targets {
+			stmt := &gorm.Statement{DB: tx}
+			if err := stmt.Parse(target.model); err != nil {
+				return err
+			}
+			result := tx.Where(target.column+" = ?", userID).Delete(target.model)
+			if result.Error != nil {
+				return fmt.Errorf("failed to delete from %s: %w", stmt.Schema.Table, result.Error)
+			}
+			receipt.DeletedRecords[stmt.Schema.Table] = result.RowsAffected
+			receipt.TotalRecords += result.RowsAffected
+		}
+
+		result := tx.Delete(&user)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete user: %w", result.Error)
+		}
+		receipt.DeletedRecords[user.TableName()] = result.RowsAffected
+		receipt.TotalRecords += result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // ユーザーが見つからない場合はnilを返す
+		}
+		return nil, err
+	}
+
+	receipt.DeletedAt = time.Now()
+	return receipt, nil
+}
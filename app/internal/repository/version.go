@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// saveVersioned 楽観的ロックでレコードを保存し、バージョンを1つ進める
+// 一覧項目を持つエンティティは、保存前に配列項目から一覧項目を組み立てる
+// currentVersionは保存の前提として読み込んだバージョン（レコードが存在しなかった場合は0）
+// 読み込み後に他の書き込みでバージョンが進んでいた場合はErrVersionConflictを返す
+func saveVersioned(tx *gorm.DB, model interface{}, currentVersion int64) error {
+	// 配列項目の値を一覧項目にまとめる（配列の長さが揃っていない場合はErrInvalidListItem）
+	if err := entity.PackListItems(model); err != nil {
+		return err
+	}
+	entity.SetModelVersion(model, currentVersion+1)
+
+	if currentVersion == 0 {
+		// 新規作成（同時に作成された場合は主キーの重複で0件になる）
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: record was created concurrently", entity.ErrVersionConflict)
+		}
+		return nil
+	}
+
+	result := tx.Model(model).Where("version = ?", currentVersion).Select("*").Updates(model)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: record was updated concurrently", entity.ErrVersionConflict)
+	}
+	return nil
+}
+
+// lockForUpdate 保存前にレコードを行ロック付きで読み込む（存在しない場合はfalse）
+func lockForUpdate(tx *gorm.DB, model interface{}, query string, args ...interface{}) (bool, error) {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(query, args...).First(model).Error
+	if err == gorm.ErrRecordNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
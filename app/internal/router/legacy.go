@@ -0,0 +1,119 @@
+package router
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// legacyDeprecatedAt 旧ルート（/api直下）を非推奨にした日時（Deprecationヘッダーの値）
+var legacyDeprecatedAt = time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+// legacyPrefix・v1Prefix 旧ルートと/api/v1のパスの接頭辞
+const (
+	legacyPrefix = "/api/"
+	v1Prefix     = "/api/v1/"
+)
+
+// legacySuccessors 旧ルートと後継の/api/v1のルートの対応（"メソッド パス"）
+// 後継のパスのパラメータは、旧ルートに同名のパラメータがあればLinkヘッダーで値に置き換える
+func legacySuccessors() map[string]string {
+	successors := map[string]string{
+		"GET /api/status":                   "GET /api/v1/status",
+		"GET /api/services/fields":          "GET /api/v1/services",
+		"GET /api/services/:service/fields": "GET /api/v1/services/:service",
+		"GET /api/sample-users":             "GET /api/v1/sample-users",
+
+		"GET /api/users/:userID":                "GET /api/v1/users/:userID",
+		"PATCH /api/users/:userID":              "PATCH /api/v1/users/:userID",
+		"DELETE /api/users/:userID":             "DELETE /api/v1/users/:userID",
+		"GET /api/users/:userID/export":         "GET /api/v1/users/:userID/export",
+		"GET /api/user/:userID/services":        "GET /api/v1/users/:userID/services",
+		"GET /api/user/:userID/service-details": "GET /api/v1/users/:userID/service-details",
+		"POST /api/user":                        "PUT /api/v1/users/:userID",
+		"POST /api/users/services":              "PUT /api/v1/users/:userID/services",
+		"POST /api/users":                       "POST /api/v1/users",
+		"POST /api/users/import":                "POST /api/v1/imports",
+
+		"GET /api/offerbox/:id/photos":          "GET /api/v1/users/:id/services/offerbox/photos",
+		"GET /api/offerbox/:id/photos/:slot":    "GET /api/v1/users/:id/services/offerbox/photos/:slot",
+		"PUT /api/offerbox/:id/photos/:slot":    "PUT /api/v1/users/:id/services/offerbox/photos/:slot",
+		"DELETE /api/offerbox/:id/photos/:slot": "DELETE /api/v1/users/:id/services/offerbox/photos/:slot",
+
+		"GET /api/user/:userID/custom-services": "GET /api/v1/users/:userID/custom-services",
+		"POST /api/custom-services":             "POST /api/v1/users/:userID/custom-services",
+		"GET /api/custom-services/:id":          "GET /api/v1/custom-services/:id",
+		"PUT /api/custom-services/:id":          "PUT /api/v1/custom-services/:id",
+		"DELETE /api/custom-services/:id":       "DELETE /api/v1/custom-services/:id",
+		"POST /api/custom-services/:id/values":  "PATCH /api/v1/custom-services/:id/values",
+
+		"GET /api/log/:id":                                   "GET /api/v1/users/:id/logs",
+		"GET /api/user/:userID/audit-events":                 "GET /api/v1/users/:userID/audit-events",
+		"GET /api/user/:userID/revisions/:table/:field":      "GET /api/v1/users/:userID/revisions/:table/:field",
+		"GET /api/user/:userID/revisions/:table/:field/diff": "GET /api/v1/users/:userID/revisions/:table/:field/diff",
+		"POST /api/revisions/:id/revert":                     "POST /api/v1/revisions/:id/revert",
+		"GET /api/user/:userID/search":                       "GET /api/v1/users/:userID/search",
+
+		"POST /api/ai/generate-profiles":           "POST /api/v1/users/:userID/ai-generations",
+		"POST /api/ai/generate-custom-service/:id": "POST /api/v1/custom-services/:id/ai-generations",
+
+		"GET /api/profile/:id":   "GET /api/v1/users/:id/profile",
+		"POST /api/profile":      "PUT /api/v1/users/:userID/profile",
+		"PATCH /api/profile/:id": "PATCH /api/v1/users/:id/profile",
+	}
+
+	// 就活サービスはレジストリから生成する
+	for _, def := range entity.Services {
+		legacy := "/api/" + def.RoutePath
+		v1 := "/api/v1/users/:id/services/" + def.RoutePath
+		successors["GET "+legacy+"/:id"] = "GET " + v1
+		successors["POST "+legacy] = "PUT /api/v1/users/:userID/services/" + def.RoutePath
+		successors["PATCH "+legacy+"/:id"] = "PATCH " + v1
+
+		if len(entity.ModelListGroups(def.NewModel())) > 0 {
+			successors["POST "+legacy+"/:id/items/:list"] = "POST " + v1 + "/items/:list"
+			successors["PUT "+legacy+"/:id/items/:list"] = "PUT " + v1 + "/items/:list"
+			successors["PUT "+legacy+"/:id/items/:list/:itemID"] = "PUT " + v1 + "/items/:list/:itemID"
+			successors["DELETE "+legacy+"/:id/items/:list/:itemID"] = "DELETE " + v1 + "/items/:list/:itemID"
+		}
+	}
+	return successors
+}
+
+// パラメータ名の違いを無視してパスを比較するためのパターン
+var routeParamPattern = regexp.MustCompile(`:[A-Za-z0-9_]+`)
+
+// verifyLegacyRoutes 全ての旧ルートに後継のルートが定義され、後継のルートが登録されているか確認
+func verifyLegacyRoutes(routes gin.RoutesInfo, successors map[string]string) error {
+	registered := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		registered[route.Method+" "+routeParamPattern.ReplaceAllString(route.Path, ":")] = true
+	}
+
+	var problems []string
+	for _, route := range routes {
+		if !strings.HasPrefix(route.Path, legacyPrefix) || strings.HasPrefix(route.Path, v1Prefix) {
+			continue
+		}
+		key := route.Method + " " + route.Path
+		successor, exists := successors[key]
+		if !exists {
+			problems = append(problems, key+" has no successor")
+			continue
+		}
+		if !registered[routeParamPattern.ReplaceAllString(successor, ":")] {
+			problems = append(problems, fmt.Sprintf("successor of %s is not registered: %s", key, successor))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("legacy routes: %s", strings.Join(problems, "; "))
+}
@@ -0,0 +1,529 @@
+package router
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/openapi"
+)
+
+// OpenAPIドキュメントとAPIドキュメントの画面のパス
+const (
+	openAPIPath = "/openapi.json"
+	docsPath    = "/docs"
+)
+
+// ifMatch 楽観的ロックのIf-Matchヘッダー
+var ifMatch = openapi.Header("If-Match", "取得時のETag（一致しない場合は412、省略時は確認しない）")
+
+// newOpenAPIDocument NewRouterで登録する全ルートのOpenAPIドキュメント
+// リクエスト・レスポンスのスキーマはエンティティの構造体から生成する（ルートを追加した場合はここにも追加する）
+// 旧ルートは非推奨とし、/api/v1の操作はリクエストの形式が変わったもの以外を旧ルートの操作から生成する
+func newOpenAPIDocument(successors map[string]string) *openapi.Document {
+	doc := openapi.New(openapi.Info{
+		Title:       "就活サービス管理API",
+		Description: "ユーザー・ES・就活サービスのプロフィールの管理とAI生成のAPI。エラーは共通のエラーレスポンス（error.code）で返します。",
+		Version:     "1.0.0",
+	})
+
+	// 独自のJSON形式で出力する型
+	doc.Generator().Override(entity.ListItem{}, &openapi.Schema{
+		Type:                 "object",
+		Description:          "一覧項目の1件（idと項目キーごとの値）",
+		Properties:           map[string]*openapi.Schema{"id": {Type: "string", Format: "uuid"}},
+		AdditionalProperties: &openapi.Schema{Type: "string"},
+	})
+	doc.Generator().Override(gorm.DeletedAt{}, &openapi.Schema{Type: "string", Format: "date-time", Nullable: true})
+
+	uuidParam := &openapi.Schema{Type: "string", Format: "uuid"}
+	doc.PathParam("userID", uuidParam)
+	doc.PathParam("id", uuidParam)
+	doc.PathParam("itemID", uuidParam)
+	doc.PathParam("slot", &openapi.Schema{Type: "integer", Description: "写真枠の番号（1始まり）"})
+	doc.PathParam("list", &openapi.Schema{Type: "string", Description: "一覧のキー（主となる配列項目のJSONキー、例: skills）"})
+	doc.PathParam("table", &openapi.Schema{Type: "string", Description: "対象テーブル（サービスキーまたはprofiles）"})
+	doc.PathParam("field", &openapi.Schema{Type: "string", Description: "項目のJSONキー"})
+	doc.PathParam("service", &openapi.Schema{Type: "string", Description: "サービスキーまたは日本語サービス名"})
+
+	message := openapi.Object(map[string]*openapi.Schema{"message": {Type: "string"}})
+
+	addHealthOperations(doc, message)
+	addUserOperations(doc, message)
+	addServiceOperations(doc)
+	addOfferBoxPhotoOperations(doc, message)
+	addCustomServiceOperations(doc, message)
+	addHistoryOperations(doc)
+	addAIOperations(doc)
+	addProfileOperations(doc)
+	addV1Operations(doc, message, successors)
+	return doc
+}
+
+// ok 200のJSONレスポンス
+func ok(description string, schema *openapi.Schema) map[string]*openapi.Response {
+	return map[string]*openapi.Response{"200": openapi.JSONResponse(description, schema)}
+}
+
+func addHealthOperations(doc *openapi.Document, message *openapi.Schema) {
+	doc.Add(http.MethodGet, "/", &openapi.Operation{
+		Tags: []string{"health"}, Summary: "疎通確認", OperationID: "getRoot",
+		Responses: ok("Hello World", message), Security: openapi.Public(),
+	})
+	doc.Add(http.MethodGet, "/healthz", &openapi.Operation{
+		Tags: []string{"health"}, Summary: "プロセスの稼働確認", OperationID: "getHealthz",
+		Responses: ok("稼働中", openapi.Object(map[string]*openapi.Schema{"status": {Type: "string"}})), Security: openapi.Public(),
+	})
+	doc.Add(http.MethodGet, "/readyz", &openapi.Operation{
+		Tags: []string{"health"}, Summary: "DB・マイグレーション・LLMの準備状況", OperationID: "getReadyz",
+		Responses: map[string]*openapi.Response{
+			"200": openapi.JSONResponse("リクエストを受け付けられる", doc.Schema(entity.ReadinessResponse{})),
+			"503": openapi.JSONResponse("準備ができていない", doc.Schema(entity.ReadinessResponse{})),
+		},
+		Security: openapi.Public(),
+	})
+	doc.Add(http.MethodGet, "/api/status", &openapi.Operation{
+		Tags: []string{"health"}, Summary: "ビルド情報と依存サービスの状態", OperationID: "getStatus",
+		Responses: ok("状態", doc.Schema(entity.StatusResponse{})), Security: openapi.Public(),
+	})
+	doc.Add(http.MethodGet, openAPIPath, &openapi.Operation{
+		Tags: []string{"docs"}, Summary: "OpenAPIドキュメント", OperationID: "getOpenAPI",
+		Responses: ok("このドキュメント", &openapi.Schema{Type: "object"}), Security: openapi.Public(),
+	})
+	doc.Add(http.MethodGet, docsPath, &openapi.Operation{
+		Tags: []string{"docs"}, Summary: "APIドキュメントの画面（Swagger UI）", OperationID: "getDocs",
+		Responses: map[string]*openapi.Response{"200": openapi.BinaryResponse("HTML", "text/html")},
+		Security:  openapi.Public(),
+	})
+	doc.Add(http.MethodGet, "/api/sample-users", &openapi.Operation{
+		Tags: []string{"sample-users"}, Summary: "サンプルユーザーの一覧", OperationID: "listSampleUsers",
+		Responses: ok("サンプルユーザー", doc.Schema([]entity.SampleUser{})),
+	})
+}
+
+func addUserOperations(doc *openapi.Document, message *openapi.Schema) {
+	user := doc.Schema(entity.User{})
+	services := openapi.Object(map[string]*openapi.Schema{"services": openapi.ArrayOf(&openapi.Schema{Type: "string"})})
+
+	doc.Add(http.MethodGet, "/api/users/:userID", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "ユーザーを取得", OperationID: "getUser",
+		Responses: ok("ユーザー（ETagヘッダーにバージョン）", user),
+	})
+	doc.Add(http.MethodPatch, "/api/users/:userID", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "ユーザーを部分更新（JSON Merge Patch）", OperationID: "patchUser",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.MergePatchBody(user),
+		Responses: ok("更新後のユーザー", user),
+	})
+	doc.Add(http.MethodDelete, "/api/users/:userID", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "アカウントと全データを削除", OperationID: "deleteUser",
+		Responses: ok("削除の証明", doc.Schema(entity.UserDeletionReceipt{})),
+	})
+	doc.Add(http.MethodGet, "/api/users/:userID/export", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "全データをZIPでエクスポート", OperationID: "exportUser",
+		Responses: map[string]*openapi.Response{"200": openapi.BinaryResponse("JSONとMarkdownのZIPアーカイブ", "application/zip")},
+	})
+	doc.Add(http.MethodGet, "/api/user/:userID/services", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "利用中の就活サービス名の一覧", OperationID: "getUserServices",
+		Responses: ok("日本語サービス名", services),
+	})
+	doc.Add(http.MethodGet, "/api/user/:userID/service-details", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "利用中の就活サービスとESのデータ", OperationID: "getUserServiceDetails",
+		Responses: ok("サービスキーごとのデータ（取得に失敗したサービスは{\"error\": ...}）", openapi.Object(map[string]*openapi.Schema{
+			"services": openapi.ArrayOf(&openapi.Schema{Type: "string"}),
+			"data":     openapi.MapOf(&openapi.Schema{}),
+		})),
+	})
+	doc.Add(http.MethodPost, "/api/user", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "ユーザーを更新", OperationID: "updateUser",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.UpdateUserRequest{})),
+		Responses: ok("更新後のユーザー", user),
+	})
+	doc.Add(http.MethodPost, "/api/users/services", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "利用中の就活サービスを更新（追加したサービスはAI生成）", OperationID: "updateUserServices",
+		Parameters: []*openapi.Parameter{ifMatch},
+		RequestBody: openapi.JSONBody(&openapi.Schema{
+			Type: "object",
+			Properties: map[string]*openapi.Schema{
+				"user_id":  {Type: "string", Format: "uuid"},
+				"services": openapi.ArrayOf(&openapi.Schema{Type: "string", Description: "日本語サービス名"}),
+			},
+			Required: []string{"user_id"},
+		}),
+		Responses: ok("更新完了", message),
+	})
+	doc.Add(http.MethodPost, "/api/users", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "ユーザーを作成", OperationID: "createUser",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.CreateUserRequest{})),
+		Responses: ok("作成したユーザー", user),
+	})
+	doc.Add(http.MethodPost, "/api/users/import", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "エクスポートしたZIPをインポート", OperationID: "importUser",
+		Parameters: []*openapi.Parameter{
+			openapi.Query("user_id", "インポート先のユーザーID（省略時はエクスポート元と同じ）", &openapi.Schema{Type: "string", Format: "uuid"}),
+			openapi.Query("dry_run", "trueの場合は保存せずに結果のみ返す", &openapi.Schema{Type: "boolean"}),
+		},
+		RequestBody: openapi.FileBody("archive"),
+		Responses:   ok("インポート結果", doc.Schema(entity.ImportResult{})),
+	})
+}
+
+func addServiceOperations(doc *openapi.Document) {
+	doc.Add(http.MethodGet, "/api/services/fields", &openapi.Operation{
+		Tags: []string{"services"}, Summary: "全サービスの項目定義", OperationID: "getAllServiceFields",
+		Responses: ok("フォーム生成用の項目定義", openapi.Object(map[string]*openapi.Schema{
+			"services": doc.Schema([]entity.ServiceMetadata{}),
+		})),
+		Security: openapi.Public(),
+	})
+	doc.Add(http.MethodGet, "/api/services/:service/fields", &openapi.Operation{
+		Tags: []string{"services"}, Summary: "サービスの項目定義", OperationID: "getServiceFields",
+		Responses: ok("フォーム生成用の項目定義", doc.Schema(entity.ServiceMetadata{})),
+		Security:  openapi.Public(),
+	})
+
+	for _, def := range entity.Services {
+		model := doc.Schema(def.NewModel())
+		name := def.ModelName()
+		tags := []string{"services"}
+		path := "/api/" + def.RoutePath
+
+		doc.Add(http.MethodGet, path+"/:id", &openapi.Operation{
+			Tags: tags, Summary: def.DisplayName + "のプロフィールを取得", OperationID: "get" + name,
+			Responses: ok("プロフィール（ETagヘッダーにバージョン）", model),
+		})
+		doc.Add(http.MethodPost, path, &openapi.Operation{
+			Tags: tags, Summary: def.DisplayName + "のプロフィールを作成・更新", OperationID: "createOrUpdate" + name,
+			Parameters: []*openapi.Parameter{ifMatch},
+			RequestBody: openapi.JSONBody(&openapi.Schema{
+				Type:       "object",
+				Properties: map[string]*openapi.Schema{"user_id": {Type: "string", Format: "uuid"}, "data": model},
+				Required:   []string{"data", "user_id"},
+			}),
+			Responses: ok("保存後のプロフィール", model),
+		})
+		doc.Add(http.MethodPatch, path+"/:id", &openapi.Operation{
+			Tags: tags, Summary: def.DisplayName + "のプロフィールを部分更新（JSON Merge Patch）", OperationID: "patch" + name,
+			Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.MergePatchBody(model),
+			Responses: ok("更新後のプロフィール", model),
+		})
+
+		if len(entity.ModelListGroups(def.NewModel())) == 0 {
+			continue
+		}
+		items := path + "/:id/items/:list"
+		doc.Add(http.MethodPost, items, &openapi.Operation{
+			Tags: tags, Summary: def.DisplayName + "の一覧項目を追加", OperationID: "add" + name + "ListItem",
+			Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.ListItemRequest{})),
+			Responses: map[string]*openapi.Response{"201": openapi.JSONResponse("更新後のプロフィール", model)},
+		})
+		doc.Add(http.MethodPut, items, &openapi.Operation{
+			Tags: tags, Summary: def.DisplayName + "の一覧項目を並べ替え", OperationID: "reorder" + name + "ListItems",
+			Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.ReorderListItemsRequest{})),
+			Responses: ok("更新後のプロフィール", model),
+		})
+		doc.Add(http.MethodPut, items+"/:itemID", &openapi.Operation{
+			Tags: tags, Summary: def.DisplayName + "の一覧項目を更新", OperationID: "update" + name + "ListItem",
+			Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.ListItemRequest{})),
+			Responses: ok("更新後のプロフィール", model),
+		})
+		doc.Add(http.MethodDelete, items+"/:itemID", &openapi.Operation{
+			Tags: tags, Summary: def.DisplayName + "の一覧項目を削除", OperationID: "delete" + name + "ListItem",
+			Parameters: []*openapi.Parameter{ifMatch},
+			Responses:  ok("更新後のプロフィール", model),
+		})
+	}
+}
+
+func addOfferBoxPhotoOperations(doc *openapi.Document, message *openapi.Schema) {
+	tags := []string{"offerbox"}
+	doc.Add(http.MethodGet, "/api/offerbox/:id/photos", &openapi.Operation{
+		Tags: tags, Summary: "「私を表す写真」の写真枠の一覧", OperationID: "getOfferBoxPhotoSlots",
+		Responses: ok("写真枠", openapi.Object(map[string]*openapi.Schema{"photos": doc.Schema([]entity.OfferBoxPhotoSlot{})})),
+	})
+	doc.Add(http.MethodGet, "/api/offerbox/:id/photos/:slot", &openapi.Operation{
+		Tags: tags, Summary: "写真を取得", OperationID: "getOfferBoxPhoto",
+		Responses: map[string]*openapi.Response{"200": openapi.BinaryResponse("画像", "image/*")},
+	})
+	doc.Add(http.MethodPut, "/api/offerbox/:id/photos/:slot", &openapi.Operation{
+		Tags: tags, Summary: "写真をアップロード", OperationID: "uploadOfferBoxPhoto",
+		RequestBody: openapi.FileBody("photo"),
+		Responses:   ok("保存した写真の情報", doc.Schema(entity.OfferBoxPhoto{})),
+	})
+	doc.Add(http.MethodDelete, "/api/offerbox/:id/photos/:slot", &openapi.Operation{
+		Tags: tags, Summary: "写真を削除", OperationID: "deleteOfferBoxPhoto",
+		Responses: ok("削除完了", message),
+	})
+}
+
+func addCustomServiceOperations(doc *openapi.Document, message *openapi.Schema) {
+	tags := []string{"custom-services"}
+	customService := doc.Schema(entity.CustomService{})
+
+	doc.Add(http.MethodGet, "/api/user/:userID/custom-services", &openapi.Operation{
+		Tags: tags, Summary: "ユーザーのカスタムサービスの一覧", OperationID: "listCustomServices",
+		Responses: ok("カスタムサービス", openapi.Object(map[string]*openapi.Schema{"custom_services": openapi.ArrayOf(customService)})),
+	})
+	doc.Add(http.MethodPost, "/api/custom-services", &openapi.Operation{
+		Tags: tags, Summary: "カスタムサービスを作成", OperationID: "createCustomService",
+		RequestBody: openapi.JSONBody(doc.Schema(entity.CreateCustomServiceRequest{})),
+		Responses:   map[string]*openapi.Response{"201": openapi.JSONResponse("作成したカスタムサービス", customService)},
+	})
+	doc.Add(http.MethodGet, "/api/custom-services/:id", &openapi.Operation{
+		Tags: tags, Summary: "カスタムサービスを取得", OperationID: "getCustomService",
+		Responses: ok("カスタムサービスとフォーム生成用の項目定義", openapi.Object(map[string]*openapi.Schema{
+			"custom_service": customService,
+			"metadata":       doc.Schema(entity.ServiceMetadata{}),
+		})),
+	})
+	doc.Add(http.MethodPut, "/api/custom-services/:id", &openapi.Operation{
+		Tags: tags, Summary: "カスタムサービスの定義を更新", OperationID: "updateCustomService",
+		RequestBody: openapi.JSONBody(doc.Schema(entity.CustomServiceData{})),
+		Responses:   ok("更新後のカスタムサービス", customService),
+	})
+	doc.Add(http.MethodDelete, "/api/custom-services/:id", &openapi.Operation{
+		Tags: tags, Summary: "カスタムサービスを削除", OperationID: "deleteCustomService",
+		Responses: ok("削除完了", message),
+	})
+	doc.Add(http.MethodPost, "/api/custom-services/:id/values", &openapi.Operation{
+		Tags: tags, Summary: "カスタムサービスの入力値を保存", OperationID: "saveCustomServiceValues",
+		RequestBody: openapi.JSONBody(doc.Schema(entity.SaveCustomServiceValuesRequest{})),
+		Responses:   ok("保存後のカスタムサービス", customService),
+	})
+}
+
+func addHistoryOperations(doc *openapi.Document) {
+	dateTime := &openapi.Schema{Type: "string", Format: "date-time"}
+	nonNegative := &openapi.Schema{Type: "integer", Minimum: floatPtr(0)}
+
+	doc.Add(http.MethodGet, "/api/log/:id", &openapi.Operation{
+		Tags: []string{"history"}, Summary: "項目ごとの最終更新日時", OperationID: "getLogs",
+		Parameters: logQueryParameters(),
+		Responses:  ok("テーブル → 項目 → 更新日時", doc.Schema(entity.LogResponse{})),
+	})
+	doc.Add(http.MethodGet, "/api/user/:userID/audit-events", &openapi.Operation{
+		Tags: []string{"history"}, Summary: "監査イベントの一覧（新しい順）", OperationID: "listAuditEvents",
+		Parameters: []*openapi.Parameter{
+			openapi.Query("actor_type", "実行者の種類", &openapi.Schema{Type: "string", Enum: entity.AuditActorTypes}),
+			openapi.Query("target_table", "対象テーブル", &openapi.Schema{Type: "string"}),
+			openapi.Query("request_id", "リクエストID", &openapi.Schema{Type: "string"}),
+			openapi.Query("from", "この日時以降", dateTime),
+			openapi.Query("to", "この日時より前", dateTime),
+			openapi.Query("limit", "取得件数", nonNegative),
+			openapi.Query("offset", "読み飛ばす件数", nonNegative),
+		},
+		Responses: ok("監査イベント", doc.Schema(entity.AuditEventList{})),
+	})
+	doc.Add(http.MethodGet, "/api/user/:userID/revisions/:table/:field", &openapi.Operation{
+		Tags: []string{"history"}, Summary: "項目の変更履歴（新しい順）", OperationID: "listRevisions",
+		Responses: ok("リビジョン", openapi.Object(map[string]*openapi.Schema{"revisions": doc.Schema([]entity.FieldRevision{})})),
+	})
+	doc.Add(http.MethodGet, "/api/user/:userID/revisions/:table/:field/diff", &openapi.Operation{
+		Tags: []string{"history"}, Summary: "2つのリビジョンの差分", OperationID: "diffRevisions",
+		Parameters: []*openapi.Parameter{
+			{Name: "from", In: "query", Description: "比較元のリビジョンID", Required: true, Schema: &openapi.Schema{Type: "string", Format: "uuid"}},
+			{Name: "to", In: "query", Description: "比較先のリビジョンID", Required: true, Schema: &openapi.Schema{Type: "string", Format: "uuid"}},
+		},
+		Responses: ok("差分", doc.Schema(entity.RevisionDiff{})),
+	})
+	doc.Add(http.MethodPost, "/api/revisions/:id/revert", &openapi.Operation{
+		Tags: []string{"history"}, Summary: "項目をリビジョンの値に戻す", OperationID: "revertToRevision",
+		Responses: ok("戻した変更のリビジョン", doc.Schema(entity.FieldRevision{})),
+	})
+	doc.Add(http.MethodGet, "/api/user/:userID/search", &openapi.Operation{
+		Tags: []string{"search"}, Summary: "ESと全サービスのプロフィールを横断検索", OperationID: "search",
+		Parameters: []*openapi.Parameter{
+			{Name: "q", In: "query", Description: "検索語", Required: true, Schema: &openapi.Schema{Type: "string"}},
+			openapi.Query("limit", "取得件数", &openapi.Schema{Type: "integer", Minimum: floatPtr(1)}),
+		},
+		Responses: ok("検索結果", doc.Schema(entity.SearchResponse{})),
+	})
+}
+
+// logQueryParameters ログの絞り込み条件
+func logQueryParameters() []*openapi.Parameter {
+	dateTime := &openapi.Schema{Type: "string", Format: "date-time"}
+	list := openapi.ArrayOf(&openapi.Schema{Type: "string"})
+	sortKeys := make([]string, 0, len(entity.LogSortKeys)*2)
+	for _, key := range entity.LogSortKeys {
+		sortKeys = append(sortKeys, key, "-"+key)
+	}
+	return []*openapi.Parameter{
+		openapi.Query("service", "サービスキー・日本語サービス名（複数指定またはカンマ区切り）", list),
+		openapi.Query("field", "項目名（複数指定またはカンマ区切り）", list),
+		openapi.Query("from", "この日時以降", dateTime),
+		openapi.Query("to", "この日時より前", dateTime),
+		openapi.Query("sort", "並び順（先頭の-は降順、既定は"+entity.DefaultLogSort+"）", &openapi.Schema{Type: "string", Enum: sortKeys}),
+		openapi.Query("limit", "取得件数（省略時は全件）", &openapi.Schema{Type: "integer", Minimum: floatPtr(0), Maximum: floatPtr(entity.MaxLogLimit)}),
+		openapi.Query("offset", "読み飛ばす件数", &openapi.Schema{Type: "integer", Minimum: floatPtr(0)}),
+	}
+}
+
+func addAIOperations(doc *openapi.Document) {
+	response := doc.Schema(entity.AIGenerationResponse{})
+	doc.Add(http.MethodPost, "/api/ai/generate-profiles", &openapi.Operation{
+		Tags: []string{"ai"}, Summary: "就活サービスのプロフィールをAIで生成", OperationID: "generateServiceProfiles",
+		RequestBody: openapi.JSONBody(doc.Schema(entity.AIGenerationRequest{})),
+		Responses: map[string]*openapi.Response{
+			"200": openapi.JSONResponse("全サービスの生成に成功", response),
+			"206": openapi.JSONResponse("一部のサービスの生成に失敗（resultsにサービスごとの結果）", response),
+		},
+	})
+	doc.Add(http.MethodPost, "/api/ai/generate-custom-service/:id", &openapi.Operation{
+		Tags: []string{"ai"}, Summary: "カスタムサービスの項目をAIで生成", OperationID: "generateCustomServiceProfile",
+		Responses: ok("生成結果", response),
+	})
+}
+
+func addProfileOperations(doc *openapi.Document) {
+	profile := openapi.Object(map[string]*openapi.Schema{
+		"message": {Type: "string"},
+		"profile": doc.Schema(entity.Profile{}),
+	})
+	doc.Add(http.MethodGet, "/api/profile/:id", &openapi.Operation{
+		Tags: []string{"profile"}, Summary: "ESを取得", OperationID: "getProfile",
+		Responses: ok("ES（ETagヘッダーにバージョン）", profile),
+	})
+	doc.Add(http.MethodPost, "/api/profile", &openapi.Operation{
+		Tags: []string{"profile"}, Summary: "ESを作成・更新", OperationID: "createOrUpdateProfile",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.CreateProfileRequest{})),
+		Responses: ok("保存後のES", profile),
+	})
+	doc.Add(http.MethodPatch, "/api/profile/:id", &openapi.Operation{
+		Tags: []string{"profile"}, Summary: "ESを部分更新（JSON Merge Patch）", OperationID: "patchProfile",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.MergePatchBody(doc.Schema(entity.ProfileData{})),
+		Responses: ok("更新後のES", profile),
+	})
+}
+
+// addProfileListItemOperations ESの一覧項目の操作（/api/v1のみ）
+func addProfileListItemOperations(doc *openapi.Document) {
+	tags := []string{"profile"}
+	profile := openapi.Object(map[string]*openapi.Schema{
+		"message": {Type: "string"},
+		"profile": doc.Schema(entity.Profile{}),
+	})
+	items := "/api/v1/users/:userID/profile/items/:list"
+	doc.Add(http.MethodPost, items, &openapi.Operation{
+		Tags: tags, Summary: "ESの一覧項目を追加", OperationID: "addProfileListItem",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.ListItemRequest{})),
+		Responses: map[string]*openapi.Response{"201": openapi.JSONResponse("更新後のES", profile)},
+	})
+	doc.Add(http.MethodPut, items, &openapi.Operation{
+		Tags: tags, Summary: "ESの一覧項目を並べ替え", OperationID: "reorderProfileListItems",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.ReorderListItemsRequest{})),
+		Responses: ok("更新後のES", profile),
+	})
+	doc.Add(http.MethodPut, items+"/:itemID", &openapi.Operation{
+		Tags: tags, Summary: "ESの一覧項目を更新", OperationID: "updateProfileListItem",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.ListItemRequest{})),
+		Responses: ok("更新後のES", profile),
+	})
+	doc.Add(http.MethodDelete, items+"/:itemID", &openapi.Operation{
+		Tags: tags, Summary: "ESの一覧項目を削除", OperationID: "deleteProfileListItem",
+		Parameters: []*openapi.Parameter{ifMatch},
+		Responses:  ok("更新後のES", profile),
+	})
+}
+
+// addV1Operations /api/v1の操作を追加し、旧ルートの操作を非推奨にする
+// ユーザーIDをパスで指定するようになった操作はリクエストボディが変わるため個別に追加し、それ以外は旧ルートの操作を複製する
+func addV1Operations(doc *openapi.Document, message *openapi.Schema, successors map[string]string) {
+	user := doc.Schema(entity.User{})
+	doc.Add(http.MethodPut, "/api/v1/users/:userID", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "ユーザーを更新", OperationID: "putUser",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.UserData{})),
+		Responses: ok("更新後のユーザー", user),
+	})
+	doc.Add(http.MethodPut, "/api/v1/users/:userID/services", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "利用中の就活サービスを更新（追加したサービスはAI生成）", OperationID: "putUserServices",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.UserServicesRequest{})),
+		Responses: ok("更新完了", message),
+	})
+	for _, def := range entity.Services {
+		model := doc.Schema(def.NewModel())
+		doc.Add(http.MethodPut, "/api/v1/users/:userID/services/"+def.RoutePath, &openapi.Operation{
+			Tags: []string{"services"}, Summary: def.DisplayName + "のプロフィールを作成・更新", OperationID: "put" + def.ModelName(),
+			Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(model),
+			Responses: ok("保存後のプロフィール", model),
+		})
+	}
+	doc.Add(http.MethodPost, "/api/v1/users/:userID/custom-services", &openapi.Operation{
+		Tags: []string{"custom-services"}, Summary: "カスタムサービスを作成", OperationID: "createUserCustomService",
+		RequestBody: openapi.JSONBody(doc.Schema(entity.CustomServiceData{})),
+		Responses:   map[string]*openapi.Response{"201": openapi.JSONResponse("作成したカスタムサービス", doc.Schema(entity.CustomService{}))},
+	})
+	response := doc.Schema(entity.AIGenerationResponse{})
+	doc.Add(http.MethodPost, "/api/v1/users/:userID/ai-generations", &openapi.Operation{
+		Tags: []string{"ai"}, Summary: "就活サービスのプロフィールをAIで生成", OperationID: "generateUserServiceProfiles",
+		RequestBody: openapi.JSONBody(doc.Schema(entity.GenerateServiceProfilesRequest{})),
+		Responses: map[string]*openapi.Response{
+			"200": openapi.JSONResponse("全サービスの生成に成功", response),
+			"206": openapi.JSONResponse("一部のサービスの生成に失敗（resultsにサービスごとの結果）", response),
+		},
+	})
+	doc.Add(http.MethodPut, "/api/v1/users/:userID/profile", &openapi.Operation{
+		Tags: []string{"profile"}, Summary: "ESを作成・更新", OperationID: "putProfile",
+		Parameters: []*openapi.Parameter{ifMatch}, RequestBody: openapi.JSONBody(doc.Schema(entity.ProfileData{})),
+		Responses: ok("保存後のES", openapi.Object(map[string]*openapi.Schema{
+			"message": {Type: "string"},
+			"profile": doc.Schema(entity.Profile{}),
+		})),
+	})
+	addProfileListItemOperations(doc)
+
+	batchResults := openapi.Object(map[string]*openapi.Schema{"results": doc.Schema([]entity.BatchPartResult{})})
+	doc.Add(http.MethodPost, "/api/v1/users/:userID/batch", &openapi.Operation{
+		Tags: []string{"users"}, Summary: "ユーザー・ES・複数の就活サービスをまとめて更新",
+		Description: "全パートを検証してから1つのトランザクションで保存します。いずれかのパートが失敗した場合は何も保存せず、エラーレスポンスの`results`にパートごとの結果を返します。",
+		OperationID: "batchUpdate",
+		RequestBody: openapi.JSONBody(doc.Schema(entity.BatchUpdateRequest{})),
+		Responses:   ok("全パートを保存（パートごとの保存後のレコード）", batchResults),
+	})
+	doc.Add(http.MethodGet, "/api/v1/users/:userID/logs", &openapi.Operation{
+		Tags: []string{"history"}, Summary: "項目ごとの最終更新日時", OperationID: "listLogs",
+		Parameters: logQueryParameters(),
+		Responses:  ok("テーブル → 項目 → 更新日時のマップと時系列の一覧", doc.Schema(entity.LogList{})),
+	})
+
+	legacyRoutes := make([]string, 0, len(successors))
+	for legacy := range successors {
+		legacyRoutes = append(legacyRoutes, legacy)
+	}
+	sort.Strings(legacyRoutes)
+	for _, legacy := range legacyRoutes {
+		legacyMethod, legacyPath, _ := strings.Cut(legacy, " ")
+		method, path, _ := strings.Cut(successors[legacy], " ")
+		path = v1RoutePath(path)
+		op := doc.Operation(legacyMethod, legacyPath)
+		if op == nil {
+			continue // ドキュメントとルートの不一致はVerifyRoutesで検出する
+		}
+
+		if doc.Operation(method, path) == nil {
+			v1 := *op
+			v1.Parameters = nil
+			for _, param := range op.Parameters {
+				if param.In != "path" { // パスパラメータはAddで後継のパスから追加する
+					v1.Parameters = append(v1.Parameters, param)
+				}
+			}
+			doc.Add(method, path, &v1)
+		}
+		op.Deprecated = true
+		op.OperationID += "Legacy"
+		op.Description = "非推奨。後継は`" + method + " " + openapi.OpenAPIPath(path) + "`（レスポンスにDeprecation・Linkヘッダーを付けます）"
+	}
+}
+
+// v1RoutePath 後継のルートのパスを登録したパスに変換
+// 旧ルートの:idをLinkヘッダーで置き換えるため、後継のパスのユーザーIDは:idで指定している場合がある
+func v1RoutePath(path string) string {
+	return strings.Replace(path, "/users/:id/", "/users/:userID/", 1)
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
@@ -1,26 +1,39 @@
 package router
 
 import (
+	"log"
 	"os"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 
+	"job-hunting-service-management-backend/app/infrastructure/auth"
+	"job-hunting-service-management-backend/app/internal/entity"
 	"job-hunting-service-management-backend/app/internal/handler"
+	"job-hunting-service-management-backend/app/internal/middleware"
+	"job-hunting-service-management-backend/app/internal/openapi"
+	"job-hunting-service-management-backend/app/internal/usecase"
 )
 
 func NewRouter(
 	suh handler.SampleUserHandler,
 	uh handler.UserHandler,
-	sh handler.SupporterzHandler,
-	csh handler.CareerSelectHandler,
-	lrh handler.LevtechRookieHandler,
-	mh handler.MynaviHandler,
-	och handler.OneCareerHandler,
+	sh handler.ServiceHandler,
+	cush handler.CustomServiceHandler,
+	oph handler.OfferBoxPhotoHandler,
 	lh handler.LogHandler,
+	frh handler.FieldRevisionHandler,
 	aih handler.AIGenerationHandler,
 	ph handler.ProfileHandler,
+	eh handler.ExportHandler,
+	ih handler.ImportHandler,
+	seh handler.SearchHandler,
+	hh handler.HealthHandler,
+	ah handler.AuditHandler,
+	bh handler.BatchUpdateHandler,
+	au usecase.AuditUsecase,
+	verifier *auth.Verifier,
 ) *gin.Engine {
 	r := gin.Default()
 
@@ -29,67 +42,222 @@ func NewRouter(
 	// CORS設定
 	config := cors.Config{
 		AllowOrigins:     []string{frontendURL},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "If-Match", middleware.RequestIDHeader},
+		ExposeHeaders:    []string{"Content-Length", "Content-Disposition", "ETag", "Deprecation", "Link", middleware.RequestIDHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}
 	r.Use(cors.New(config))
 
+	// リクエストIDの払い出しと、変更を伴うリクエストの監査イベントの記録
+	r.Use(middleware.Audit(au))
+
+	// c.Errorで設定されたエラーを共通のエラーレスポンスに変換（存在しないパスも同じ形式の404にする）
+	r.Use(middleware.Errors())
+	r.NoRoute(middleware.NoRoute)
+
 	r.GET("/", func(c *gin.Context) {
 		c.JSON(200, gin.H{
 			"message": "Hello World",
-			"status":  "Database connected successfully",
 		})
 	})
 
+	// 稼働状況の確認（Renderのヘルスチェックには/readyzを指定）
+	r.GET("/healthz", hh.Healthz)
+	r.GET("/readyz", hh.Readyz)
+
+	// --- /api/v1 ---
+	// 就活サービスの項目定義（フォーム生成用、ユーザーのデータを含まないため認証不要）
+	r.GET("/api/v1/status", hh.Status)
+	r.GET("/api/v1/services", sh.GetAllServiceFields)
+	r.GET("/api/v1/services/:service", sh.GetServiceFields)
+
+	// ここから下はアクセストークンが必要（ユーザーのデータは本人または管理者のみ操作できる）
+	v1 := r.Group("/api/v1", middleware.Auth(verifier))
+	v1.GET("/sample-users", suh.GetAllSampleUsers)
+	v1.POST("/users", uh.CreateUser)
+	v1.POST("/imports", ih.ImportUser)
+
+	// ユーザーのデータは/users/:userID以下のリソースとして扱う（ユーザーIDはパスで指定する）
+	userV1 := v1.Group("/users/:userID")
+	{
+		userV1.GET("", uh.GetUserByID)
+		userV1.PUT("", uh.PutUser)
+		userV1.PATCH("", uh.PatchUser)
+		userV1.DELETE("", uh.DeleteUser)
+		// ユーザー・ES・複数の就活サービスを1つのトランザクションでまとめて更新
+		userV1.POST("/batch", bh.BatchUpdate)
+		userV1.GET("/export", eh.ExportUser)
+		userV1.GET("/service-details", uh.GetUserServiceDetails)
+
+		// 利用中の就活サービス
+		userV1.GET("/services", uh.GetUserServices)
+		userV1.PUT("/services", uh.PutUserServices)
+
+		// 就活サービスのプロフィール（サポーターズ、マイナビ等）
+		for _, def := range entity.Services {
+			serviceRoutes := userV1.Group("/services/" + def.RoutePath)
+			serviceRoutes.GET("", sh.GetByID(def.Key))
+			serviceRoutes.PUT("", sh.Put(def.Key))
+			serviceRoutes.PATCH("", sh.Patch(def.Key))
+
+			// 一覧項目（スキルとその説明など）の追加・並べ替え・更新・削除
+			if len(entity.ModelListGroups(def.NewModel())) > 0 {
+				itemRoutes := serviceRoutes.Group("/items/:list")
+				itemRoutes.POST("", sh.AddListItem(def.Key))
+				itemRoutes.PUT("", sh.ReorderListItems(def.Key))
+				itemRoutes.PUT("/:itemID", sh.UpdateListItem(def.Key))
+				itemRoutes.DELETE("/:itemID", sh.DeleteListItem(def.Key))
+			}
+		}
+
+		// OfferBoxの「私を表す写真」
+		userV1.GET("/services/offerbox/photos", oph.GetPhotoSlots)
+		userV1.GET("/services/offerbox/photos/:slot", oph.GetPhoto)
+		userV1.PUT("/services/offerbox/photos/:slot", oph.UploadPhoto)
+		userV1.DELETE("/services/offerbox/photos/:slot", oph.DeletePhoto)
+
+		// プロフィール（ES）
+		userV1.GET("/profile", ph.GetProfileByUserID)
+		userV1.PUT("/profile", ph.PutProfile)
+		userV1.PATCH("/profile", ph.PatchProfile)
+
+		// ESの一覧項目（製作物とその説明など）の追加・並べ替え・更新・削除
+		profileItemRoutes := userV1.Group("/profile/items/:list")
+		profileItemRoutes.POST("", ph.AddListItem)
+		profileItemRoutes.PUT("", ph.ReorderListItems)
+		profileItemRoutes.PUT("/:itemID", ph.UpdateListItem)
+		profileItemRoutes.DELETE("/:itemID", ph.DeleteListItem)
+
+		// ユーザー定義のカスタムサービス
+		userV1.GET("/custom-services", cush.GetCustomServicesByUserID)
+		userV1.POST("/custom-services", cush.CreateUserCustomService)
+
+		// ログ・監査イベント・項目ごとの変更履歴・横断検索・AI生成
+		userV1.GET("/logs", lh.ListLogs)
+		userV1.GET("/audit-events", ah.GetAuditEvents)
+		userV1.GET("/revisions/:table/:field", frh.GetRevisions)
+		userV1.GET("/revisions/:table/:field/diff", frh.DiffRevisions)
+		userV1.GET("/search", seh.Search)
+		userV1.POST("/ai-generations", aih.GenerateUserServiceProfiles)
+	}
+
+	// IDで指定するリソース（所有者はユースケースが確認する）
+	customServiceV1 := v1.Group("/custom-services/:id")
+	{
+		customServiceV1.GET("", cush.GetCustomServiceByID)
+		customServiceV1.PUT("", cush.UpdateCustomService)
+		customServiceV1.DELETE("", cush.DeleteCustomService)
+		customServiceV1.PATCH("/values", cush.SaveCustomServiceValues)
+		customServiceV1.POST("/ai-generations", aih.GenerateCustomServiceProfile)
+	}
+	v1.POST("/revisions/:id/revert", frh.RevertToRevision)
+
+	// --- 旧ルート（非推奨。/api/v1の同じ操作の別名として残し、Deprecation・Linkヘッダーで後継のルートを示す） ---
+	successors := legacySuccessors()
+	deprecated := middleware.Deprecated(legacyDeprecatedAt, successors)
+	legacy := r.Group("/api", deprecated)
+	legacy.GET("/status", hh.Status)
+	legacy.GET("/services/fields", sh.GetAllServiceFields)
+	legacy.GET("/services/:service/fields", sh.GetServiceFields)
+
+	api := r.Group("/api", deprecated, middleware.Auth(verifier))
+
 	// サンプルユーザー
-	r.GET("/api/sample-users", suh.GetAllSampleUsers)
+	api.GET("/sample-users", suh.GetAllSampleUsers)
 
 	// 新しいエンドポイント
-	r.GET("/api/users/:userID", uh.GetUserByID)
-	r.GET("/api/user/:userID/services", uh.GetUserServices)
-	r.GET("/api/user/:userID/service-details", uh.GetUserServiceDetails)
+	api.GET("/users/:userID", uh.GetUserByID)
+	api.PATCH("/users/:userID", uh.PatchUser)
+	api.DELETE("/users/:userID", uh.DeleteUser)
+	api.GET("/users/:userID/export", eh.ExportUser)
+	api.GET("/user/:userID/services", uh.GetUserServices)
+	api.GET("/user/:userID/service-details", uh.GetUserServiceDetails)
 	// ユーザー
-	r.POST("/api/user", uh.UpdateUser)
-	userRoutes := r.Group("/api/users")
+	api.POST("/user", uh.UpdateUser)
+	userRoutes := api.Group("/users")
 	{
 		userRoutes.POST("/services", uh.UpdateUserServices) // 新しいエンドポイント
 		userRoutes.POST("", uh.CreateUser)
+		userRoutes.POST("/import", ih.ImportUser)
 	}
 
-	// サポーターズ
-	r.GET("/api/supporterz/:id", sh.GetSupporterzByID)
-	r.POST("/api/supporterz", sh.CreateOrUpdateSupporterz)
-
-	// キャリアセレクト
-	r.GET("/api/career-select/:id", csh.GetCareerSelectByID)
-	r.POST("/api/career-select", csh.CreateOrUpdateCareerSelect)
+	// 就活サービス（サポーターズ、マイナビ等）
+	for _, def := range entity.Services {
+		api.GET("/"+def.RoutePath+"/:id", sh.GetByID(def.Key))
+		api.POST("/"+def.RoutePath, sh.CreateOrUpdate(def.Key))
+		api.PATCH("/"+def.RoutePath+"/:id", sh.Patch(def.Key))
 
-	// レバテックルーキー
-	r.GET("/api/levtech-rookie/:id", lrh.GetLevtechRookieByID)
-	r.POST("/api/levtech-rookie", lrh.CreateOrUpdateLevtechRookie)
+		// 一覧項目（スキルとその説明など）の追加・並べ替え・更新・削除
+		if len(entity.ModelListGroups(def.NewModel())) > 0 {
+			itemRoutes := api.Group("/" + def.RoutePath + "/:id/items/:list")
+			{
+				itemRoutes.POST("", sh.AddListItem(def.Key))
+				itemRoutes.PUT("", sh.ReorderListItems(def.Key))
+				itemRoutes.PUT("/:itemID", sh.UpdateListItem(def.Key))
+				itemRoutes.DELETE("/:itemID", sh.DeleteListItem(def.Key))
+			}
+		}
+	}
 
-	// マイナビ
-	r.GET("/api/mynavi/:id", mh.GetMynaviByID)
-	r.POST("/api/mynavi", mh.CreateOrUpdateMynavi)
+	// OfferBoxの「私を表す写真」
+	offerBoxPhotoRoutes := api.Group("/offerbox/:id/photos")
+	{
+		offerBoxPhotoRoutes.GET("", oph.GetPhotoSlots)
+		offerBoxPhotoRoutes.GET("/:slot", oph.GetPhoto)
+		offerBoxPhotoRoutes.PUT("/:slot", oph.UploadPhoto)
+		offerBoxPhotoRoutes.DELETE("/:slot", oph.DeletePhoto)
+	}
 
-	// ワンキャリア
-	r.GET("/api/one-career/:id", och.GetOneCareerByID)
-	r.POST("/api/one-career", och.CreateOrUpdateOneCareer)
+	// ユーザー定義のカスタムサービス
+	api.GET("/user/:userID/custom-services", cush.GetCustomServicesByUserID)
+	customServiceRoutes := api.Group("/custom-services")
+	{
+		customServiceRoutes.POST("", cush.CreateCustomService)
+		customServiceRoutes.GET("/:id", cush.GetCustomServiceByID)
+		customServiceRoutes.PUT("/:id", cush.UpdateCustomService)
+		customServiceRoutes.DELETE("/:id", cush.DeleteCustomService)
+		customServiceRoutes.POST("/:id/values", cush.SaveCustomServiceValues)
+	}
 
 	// ログ
-	r.GET("/api/log/:id", lh.GetLogsByUserID)
+	api.GET("/log/:id", lh.GetLogsByUserID)
+
+	// 監査イベント（誰が・どこから・何を変更したか）
+	api.GET("/user/:userID/audit-events", ah.GetAuditEvents)
+
+	// 項目ごとの変更履歴
+	api.GET("/user/:userID/revisions/:table/:field", frh.GetRevisions)
+	api.GET("/user/:userID/revisions/:table/:field/diff", frh.DiffRevisions)
+	api.POST("/revisions/:id/revert", frh.RevertToRevision)
+
+	// ESと全サービスのプロフィールを横断した検索
+	api.GET("/user/:userID/search", seh.Search)
 
 	// AI生成
-	r.POST("/api/ai/generate-profiles", aih.GenerateServiceProfiles)
+	api.POST("/ai/generate-profiles", aih.GenerateServiceProfiles)
+	api.POST("/ai/generate-custom-service/:id", aih.GenerateCustomServiceProfile)
 
 	// --- プロフィール（ES） ---
-	profileRoutes := r.Group("/api/profile")
+	profileRoutes := api.Group("/profile")
 	{
 		profileRoutes.GET("/:id", ph.GetProfileByUserID)
 		profileRoutes.POST("", ph.CreateOrUpdateProfile)
+		profileRoutes.PATCH("/:id", ph.PatchProfile)
+	}
+
+	// OpenAPIドキュメントとAPIドキュメントの画面（認証不要）
+	doc := newOpenAPIDocument(successors)
+	r.GET(openAPIPath, doc.Handler())
+	r.GET(docsPath, openapi.DocsHandler(doc.Info.Title, openAPIPath))
+
+	// ドキュメントに記載していないルート・後継のない旧ルートはrouter_test.goで検出する（起動時は警告のみ）
+	if err := doc.VerifyRoutes(r.Routes()); err != nil {
+		log.Printf("Warning: OpenAPI document does not match routes: %v", err)
+	}
+	if err := verifyLegacyRoutes(r.Routes(), successors); err != nil {
+		log.Printf("Warning: legacy routes are not consistent: %v", err)
 	}
 
 	return r
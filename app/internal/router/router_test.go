@@ -0,0 +1,50 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/handler"
+)
+
+// newTestRouter ユースケースを持たないハンドラーでルートだけを登録したルーター
+func newTestRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	t.Setenv("FRONTEND_URL", "http://localhost:3000")
+	return NewRouter(
+		handler.NewSampleUserHandler(nil),
+		handler.NewUserHandler(nil, nil, nil, nil),
+		handler.NewServiceHandler(nil),
+		handler.NewCustomServiceHandler(nil),
+		handler.NewOfferBoxPhotoHandler(nil),
+		handler.NewLogHandler(nil),
+		handler.NewFieldRevisionHandler(nil),
+		handler.NewAIGenerationHandler(nil),
+		handler.NewProfileHandler(nil),
+		handler.NewExportHandler(nil),
+		handler.NewImportHandler(nil),
+		handler.NewSearchHandler(nil),
+		handler.NewHealthHandler(nil),
+		handler.NewAuditHandler(nil),
+		handler.NewBatchUpdateHandler(nil),
+		nil,
+		nil,
+	)
+}
+
+func TestOpenAPIDocumentMatchesRoutes(t *testing.T) {
+	r := newTestRouter(t)
+	doc := newOpenAPIDocument(legacySuccessors())
+	if err := doc.VerifyRoutes(r.Routes()); err != nil {
+		t.Fatalf("VerifyRoutes() = %v, want nil", err)
+	}
+}
+
+func TestLegacyRoutesHaveSuccessors(t *testing.T) {
+	r := newTestRouter(t)
+	if err := verifyLegacyRoutes(r.Routes(), legacySuccessors()); err != nil {
+		t.Fatalf("verifyLegacyRoutes() = %v, want nil", err)
+	}
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -15,16 +17,19 @@ import (
 
 type AIGenerationUsecase interface {
 	GenerateServiceProfiles(c *gin.Context, userID uuid.UUID, services []string) (*entity.AIGenerationResponse, error)
+	GenerateCustomServiceProfile(c *gin.Context, customServiceID uuid.UUID) (*entity.AIGenerationResponse, error)
 }
 
 type aiGenerationUsecase struct {
 	repo         repository.AIGenerationRepository
+	uow          repository.UnitOfWork
 	geminiClient *client.GeminiClient
 }
 
-func NewAIGenerationUsecase(repo repository.AIGenerationRepository, geminiClient *client.GeminiClient) AIGenerationUsecase {
+func NewAIGenerationUsecase(repo repository.AIGenerationRepository, uow repository.UnitOfWork, geminiClient *client.GeminiClient) AIGenerationUsecase {
 	return &aiGenerationUsecase{
 		repo:         repo,
+		uow:          uow,
 		geminiClient: geminiClient,
 	}
 }
@@ -33,6 +38,7 @@ func (u *aiGenerationUsecase) GenerateServiceProfiles(c *gin.Context, userID uui
 	// ユーザー情報を取得
 	user, err := u.repo.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
+		err = userNotFound(err, userID)
 		return &entity.AIGenerationResponse{
 			UserID:  userID,
 			Status:  "error",
@@ -40,9 +46,21 @@ func (u *aiGenerationUsecase) GenerateServiceProfiles(c *gin.Context, userID uui
 		}, err
 	}
 
+	// ESを取得（ESの内容を使うプロンプト向け。未登録の場合はnil）
+	profile, err := u.repo.GetProfileByUserID(c.Request.Context(), userID)
+	if err != nil {
+		return &entity.AIGenerationResponse{
+			UserID:  userID,
+			Status:  "error",
+			Message: fmt.Sprintf("Failed to get profile: %v", err),
+		}, err
+	}
+	promptData := &entity.PromptData{User: user, Profile: profile}
+
 	results := make(map[string]interface{})
 	successCount := 0
 	errorMessages := []string{}
+	var failures []error
 
 	// 各サービスに対してコンテンツを生成
 	for _, serviceName := range services {
@@ -54,11 +72,28 @@ func (u *aiGenerationUsecase) GenerateServiceProfiles(c *gin.Context, userID uui
 			japaneseServiceName = serviceName // 変換できない場合は元の名前を使用
 		}
 
-		generatedData, err := u.geminiClient.GenerateServiceContent(c.Request.Context(), serviceName, user)
+		// 生成中にフォームから更新された場合に上書きしないよう、生成開始時点のバージョンを取得
+		baseVersion, err := u.serviceVersion(c.Request.Context(), serviceName, userID)
+		if err != nil {
+			errorMsg := fmt.Sprintf("Failed to get current data for %s: %v", japaneseServiceName, err)
+			log.Printf("Error: %s", errorMsg)
+			u.recordHistory(c.Request.Context(), userID, serviceName, nil, errorMsg)
+			errorMessages = append(errorMessages, errorMsg)
+			failures = append(failures, fmt.Errorf("%s: %w", japaneseServiceName, err))
+			results[japaneseServiceName] = map[string]interface{}{
+				"status": "error",
+				"error":  errorMsg,
+			}
+			continue
+		}
+
+		generatedData, err := u.geminiClient.GenerateServiceContent(c.Request.Context(), serviceName, promptData)
 		if err != nil {
 			errorMsg := fmt.Sprintf("Failed to generate content for %s: %v", japaneseServiceName, err)
 			log.Printf("Error: %s", errorMsg)
+			u.recordHistory(c.Request.Context(), userID, serviceName, nil, errorMsg)
 			errorMessages = append(errorMessages, errorMsg)
+			failures = append(failures, fmt.Errorf("%s: %w", japaneseServiceName, err))
 			results[japaneseServiceName] = map[string]interface{}{
 				"status": "error",
 				"error":  errorMsg,
@@ -67,11 +102,13 @@ func (u *aiGenerationUsecase) GenerateServiceProfiles(c *gin.Context, userID uui
 		}
 
 		// 生成されたデータを対応するテーブルに保存
-		err = u.saveServiceData(c.Request.Context(), serviceName, userID, generatedData)
+		err = u.saveServiceData(c.Request.Context(), serviceName, userID, generatedData, baseVersion)
 		if err != nil {
 			errorMsg := fmt.Sprintf("Failed to save data for %s: %v", japaneseServiceName, err)
 			log.Printf("Error: %s", errorMsg)
+			u.recordHistory(c.Request.Context(), userID, serviceName, generatedData, errorMsg)
 			errorMessages = append(errorMessages, errorMsg)
+			failures = append(failures, fmt.Errorf("%s: %w", japaneseServiceName, err))
 			results[japaneseServiceName] = map[string]interface{}{
 				"status": "error",
 				"error":  errorMsg,
@@ -79,6 +116,7 @@ func (u *aiGenerationUsecase) GenerateServiceProfiles(c *gin.Context, userID uui
 			continue
 		}
 
+		u.recordHistory(c.Request.Context(), userID, serviceName, generatedData, "")
 		results[japaneseServiceName] = map[string]interface{}{
 			"status": "success",
 			"data":   generatedData,
@@ -97,27 +135,207 @@ func (u *aiGenerationUsecase) GenerateServiceProfiles(c *gin.Context, userID uui
 		message = fmt.Sprintf("Generated profiles for %d out of %d services. Errors: %v", successCount, len(services), errorMessages)
 	}
 
-	return &entity.AIGenerationResponse{
+	response := &entity.AIGenerationResponse{
 		UserID:  userID,
 		Results: results,
 		Status:  status,
 		Message: message,
-	}, nil
+	}
+	if successCount == 0 {
+		// 全サービスで失敗した場合は、各サービスのエラーをまとめて返す（種類はエラーのマッピングで判定）
+		return response, errors.Join(failures...)
+	}
+	return response, nil
+}
+
+func (u *aiGenerationUsecase) serviceVersion(ctx context.Context, serviceName string, userID uuid.UUID) (int64, error) {
+	def, exists := entity.FindService(serviceName)
+	if !exists {
+		return 0, nil // 未対応のサービスは保存時にエラーとする
+	}
+	return u.repo.GetServiceVersion(ctx, def, userID)
 }
 
-func (u *aiGenerationUsecase) saveServiceData(ctx context.Context, serviceName string, userID uuid.UUID, data map[string]interface{}) error {
-	switch serviceName {
-	case "supporterz":
-		return u.repo.SaveSupporterzData(ctx, userID, data)
-	case "career_select":
-		return u.repo.SaveCareerSelectData(ctx, userID, data)
-	case "one_career":
-		return u.repo.SaveOneCareerData(ctx, userID, data)
-	case "mynavi":
-		return u.repo.SaveMynaviData(ctx, userID, data)
-	case "levtech_rookie":
-		return u.repo.SaveLevtechRookieData(ctx, userID, data)
-	default:
+func (u *aiGenerationUsecase) saveServiceData(ctx context.Context, serviceName string, userID uuid.UUID, data map[string]interface{}, baseVersion int64) error {
+	def, exists := entity.FindService(serviceName)
+	if !exists {
 		return fmt.Errorf("unsupported service: %s", serviceName)
 	}
+	model := entity.NewGeneratedServiceModel(def, userID, data)
+
+	// サービスデータの保存・変更履歴・ログを1つのトランザクションで書き込む
+	err := u.uow.Do(ctx, func(tx repository.Tx) error {
+		sr := tx.Services(def)
+		before, err := sr.GetByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to get existing %s data: %w", def.Key, err)
+		}
+
+		// 生成中にフォームなどから更新されていた場合は上書きしない
+		// （読み込み後の更新も、保存時のバージョンの条件でErrVersionConflictになる）
+		if currentVersion := entity.ModelVersion(before); currentVersion != baseVersion {
+			return fmt.Errorf("%w: %s was updated during generation (version %d -> %d)", entity.ErrVersionConflict, def.Key, baseVersion, currentVersion)
+		}
+
+		// 一覧項目のIDを引き継ぎ、生成結果の配列の長さを揃える
+		entity.SetListItemsFrom(model, before)
+		entity.AlignListItems(model)
+
+		if _, err := sr.CreateOrUpdate(ctx, model, baseVersion); err != nil {
+			return fmt.Errorf("failed to save %s data: %w", def.Key, err)
+		}
+		return tx.RecordChanges(ctx, userID, def.Key, entity.RevisionSourceAI, before, model, def.FieldKeys())
+	})
+	if err != nil {
+		return err
+	}
+	recordAuditChange(ctx, userID, def.Key, entity.RevisionSourceAI, def.FieldKeys())
+	return nil
+}
+
+// GenerateCustomServiceProfile ユーザー定義サービスの項目を、項目定義から組み立てた汎用プロンプトで生成
+func (u *aiGenerationUsecase) GenerateCustomServiceProfile(c *gin.Context, customServiceID uuid.UUID) (*entity.AIGenerationResponse, error) {
+	customService, err := u.repo.GetCustomServiceByID(c.Request.Context(), customServiceID)
+	if err != nil {
+		return &entity.AIGenerationResponse{
+			Status:  "error",
+			Message: fmt.Sprintf("Failed to get custom service: %v", err),
+		}, err
+	}
+	if customService == nil {
+		err := fmt.Errorf("%w: %s", ErrCustomServiceNotFound, customServiceID)
+		return &entity.AIGenerationResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+	if err := authorizeResource(c, customService.UserID, fmt.Errorf("%w: %s", ErrCustomServiceNotFound, customServiceID)); err != nil {
+		return &entity.AIGenerationResponse{
+			Status:  "error",
+			Message: err.Error(),
+		}, err
+	}
+
+	user, err := u.repo.GetUserByID(c.Request.Context(), customService.UserID)
+	if err != nil {
+		err = userNotFound(err, customService.UserID)
+		return &entity.AIGenerationResponse{
+			UserID:  customService.UserID,
+			Status:  "error",
+			Message: fmt.Sprintf("Failed to get user information: %v", err),
+		}, err
+	}
+
+	log.Printf("Generating content for custom service: %s", customService.Name)
+
+	generatedData, err := u.geminiClient.GenerateCustomServiceContent(c.Request.Context(), customService, user)
+	if err != nil {
+		return u.customServiceErrorResponse(c.Request.Context(), customService, nil, fmt.Sprintf("Failed to generate content for %s: %v", customService.Name, err)), err
+	}
+
+	// 項目定義にない項目は取り込まず、型・文字数を検証してから保存
+	values, err := customService.NormalizeValues(customService.FilterValues(generatedData))
+	if err != nil {
+		// 生成結果の不備はリクエストの検証エラーではないため、外部サービスの失敗として返す
+		err = fmt.Errorf("%w: %v", entity.ErrUpstream, err)
+		return u.customServiceErrorResponse(c.Request.Context(), customService, generatedData, fmt.Sprintf("Generated content for %s does not match its fields: %v", customService.Name, err)), err
+	}
+
+	if err := u.saveCustomServiceValues(c.Request.Context(), customService.ID, values, customService.Version); err != nil {
+		return u.customServiceErrorResponse(c.Request.Context(), customService, values, fmt.Sprintf("Failed to save data for %s: %v", customService.Name, err)), err
+	}
+
+	log.Printf("Successfully generated and saved content for custom service: %s", customService.Name)
+	u.recordHistory(c.Request.Context(), customService.UserID, customService.LogTable(), values, "")
+
+	return &entity.AIGenerationResponse{
+		UserID: customService.UserID,
+		Results: map[string]interface{}{
+			customService.Name: map[string]interface{}{
+				"status": "success",
+				"data":   values,
+			},
+		},
+		Status:  "success",
+		Message: "Successfully generated profile for custom service",
+	}, nil
+}
+
+// 生成した入力値を保存し、ログを同じトランザクションで更新
+// 生成開始時点のバージョン（baseVersion）から更新されていた場合は上書きせずErrVersionConflictを返す
+func (u *aiGenerationUsecase) saveCustomServiceValues(ctx context.Context, customServiceID uuid.UUID, values entity.CustomServiceValues, baseVersion int64) error {
+	fieldNames := make([]string, 0, len(values))
+	for key := range values {
+		fieldNames = append(fieldNames, key)
+	}
+
+	var userID uuid.UUID
+	var logTable string
+	err := u.uow.Do(ctx, func(tx repository.Tx) error {
+		// 生成中の編集を失わないよう、最新の行に生成した項目だけを書き込む
+		customService, err := tx.CustomServices().GetCustomServiceByID(ctx, customServiceID)
+		if err != nil {
+			return fmt.Errorf("failed to get custom service: %w", err)
+		}
+		if customService == nil {
+			return fmt.Errorf("%w: %s", ErrCustomServiceNotFound, customServiceID)
+		}
+		if customService.Version != baseVersion {
+			return fmt.Errorf("%w: custom service %s was updated during generation (version %d -> %d)", entity.ErrVersionConflict, customServiceID, baseVersion, customService.Version)
+		}
+		userID, logTable = customService.UserID, customService.LogTable()
+
+		if customService.Values == nil {
+			customService.Values = entity.CustomServiceValues{}
+		}
+		for key, value := range values {
+			customService.Values[key] = value
+		}
+		customService.UpdatedAt = time.Now()
+
+		if _, err := tx.CustomServices().CreateOrUpdateCustomService(ctx, customService, baseVersion); err != nil {
+			return fmt.Errorf("failed to save custom service values: %w", err)
+		}
+		return tx.Logs().UpsertLogs(ctx, userID, logTable, fieldNames)
+	})
+	if err != nil {
+		return err
+	}
+	recordAuditChange(ctx, userID, logTable, entity.RevisionSourceAI, fieldNames)
+	return nil
+}
+
+func (u *aiGenerationUsecase) customServiceErrorResponse(ctx context.Context, customService *entity.CustomService, generatedData map[string]interface{}, errorMsg string) *entity.AIGenerationResponse {
+	log.Printf("Error: %s", errorMsg)
+	u.recordHistory(ctx, customService.UserID, customService.LogTable(), generatedData, errorMsg)
+	return &entity.AIGenerationResponse{
+		UserID: customService.UserID,
+		Results: map[string]interface{}{
+			customService.Name: map[string]interface{}{
+				"status": "error",
+				"error":  errorMsg,
+			},
+		},
+		Status:  "error",
+		Message: errorMsg,
+	}
+}
+
+// AI生成の実行履歴を記録（記録に失敗しても生成結果には影響させない）
+func (u *aiGenerationUsecase) recordHistory(ctx context.Context, userID uuid.UUID, targetTable string, generatedData map[string]interface{}, errorMsg string) {
+	history := &entity.AIGenerationHistory{
+		ID:            uuid.New(),
+		UserID:        userID,
+		TargetTable:   targetTable,
+		Status:        entity.AIGenerationStatusSuccess,
+		GeneratedData: entity.JSONMap(generatedData),
+		CreatedAt:     time.Now(),
+	}
+	if errorMsg != "" {
+		history.Status = entity.AIGenerationStatusError
+		history.Error = errorMsg
+	}
+	if err := u.repo.SaveGenerationHistory(ctx, history); err != nil {
+		log.Printf("Failed to record AI generation history for %s: %v", targetTable, err)
+	}
 }
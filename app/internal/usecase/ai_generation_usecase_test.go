@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/infrastructure/client"
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository/memory"
+)
+
+func TestGenerateCustomServiceProfileNotFound(t *testing.T) {
+	t.Setenv("GEMINI_API_KEY", "")
+	repos := memory.NewRepositories()
+	u := NewAIGenerationUsecase(repos.AIGeneration, repos.UnitOfWork, client.NewGeminiClient())
+
+	resp, err := u.GenerateCustomServiceProfile(newTestContext(t), uuid.New())
+	if !errors.Is(err, ErrCustomServiceNotFound) || !errors.Is(err, entity.ErrNotFound) {
+		t.Fatalf("GenerateCustomServiceProfile() error = %v, want ErrCustomServiceNotFound", err)
+	}
+	if resp == nil || resp.Status != "error" {
+		t.Fatalf("GenerateCustomServiceProfile() response = %+v, want status error", resp)
+	}
+}
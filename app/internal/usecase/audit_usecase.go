@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// AuditUsecase 監査イベントの記録・閲覧のビジネスロジック
+type AuditUsecase interface {
+	// リクエスト中に記録された変更から監査イベントを保存（変更がない場合は何もしない）
+	RecordRequest(ctx context.Context, audit *entity.AuditContext, statusCode int) error
+	// CLIなどHTTP以外からの変更を、実行者をsystemとして記録
+	RecordSystemChange(ctx context.Context, command string, userID uuid.UUID, changes entity.AuditChanges) error
+	GetEvents(c *gin.Context, userID uuid.UUID, filter entity.AuditFilter) (*entity.AuditEventList, error)
+}
+
+type auditUsecase struct {
+	ar repository.AuditRepository
+}
+
+func NewAuditUsecase(r repository.AuditRepository) AuditUsecase {
+	return &auditUsecase{ar: r}
+}
+
+func (u *auditUsecase) RecordRequest(ctx context.Context, audit *entity.AuditContext, statusCode int) error {
+	event := audit.Event(statusCode)
+	if event == nil {
+		return nil
+	}
+	return u.ar.CreateEvent(ctx, event)
+}
+
+func (u *auditUsecase) RecordSystemChange(ctx context.Context, command string, userID uuid.UUID, changes entity.AuditChanges) error {
+	event := entity.NewSystemAuditEvent(command, userID, changes)
+	if event == nil {
+		return nil
+	}
+	return u.ar.CreateEvent(ctx, event)
+}
+
+func (u *auditUsecase) GetEvents(c *gin.Context, userID uuid.UUID, filter entity.AuditFilter) (*entity.AuditEventList, error) {
+	if err := entity.ValidateAuditFilter(&filter); err != nil {
+		return nil, err
+	}
+	return u.ar.GetEvents(c, userID, filter)
+}
+
+// recordAuditChange リクエストの監査情報に変更を追加（保存のコミット後に呼び出す）
+// CLIから呼ばれた場合（gin.Contextがnil）は何もしない
+func recordAuditChange(ctx context.Context, userID uuid.UUID, targetTable, source string, fields []string) {
+	if c, ok := ctx.(*gin.Context); ok && c == nil {
+		return
+	}
+	if audit := entity.AuditContextFrom(ctx); audit != nil {
+		audit.RecordChange(userID, entity.AuditChange{TargetTable: targetTable, Fields: fields, Source: source})
+	}
+}
+
+// sortedKeys 更新用データマップの項目名（監査イベント用に名前順）
+func sortedKeys(data map[string]interface{}) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
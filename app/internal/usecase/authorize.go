@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+)
+
+// authorizeOwner IDで指定されたデータ（カスタムサービス・リビジョン・アーカイブ）の所有者に呼び出し元がアクセスできるか確認
+// URL・リクエストボディのユーザーIDはハンドラーが確認する。CLIから呼ばれた場合（gin.Contextがnil）は制限しない
+func authorizeOwner(ctx context.Context, userID uuid.UUID) error {
+	if c, ok := ctx.(*gin.Context); ok && c == nil {
+		return nil
+	}
+	return entity.AuthorizeUser(ctx, userID)
+}
+
+// authorizeResource IDで指定されたデータの所有者を確認し、他のユーザーのデータは存在しないものとしてnotFoundを返す
+// 403にすると他のユーザーのデータのIDが存在することが分かるため
+func authorizeResource(ctx context.Context, ownerID uuid.UUID, notFound error) error {
+	if err := authorizeOwner(ctx, ownerID); err != nil {
+		if errors.Is(err, entity.ErrForbidden) {
+			return notFound
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// BatchUpdateUsecase ユーザー・ES・複数の就活サービスをまとめて更新するビジネスロジック
+type BatchUpdateUsecase interface {
+	// 全パートを検証してから1つのトランザクションで保存する
+	// いずれかのパートが失敗した場合はどのパートも保存せず、パートごとの結果を持つ*entity.BatchErrorを返す
+	Update(c *gin.Context, userID uuid.UUID, req entity.BatchUpdateRequest) (*entity.BatchUpdateResponse, error)
+}
+
+type batchUpdateUsecase struct {
+	uow repository.UnitOfWork
+}
+
+func NewBatchUpdateUsecase(uow repository.UnitOfWork) BatchUpdateUsecase {
+	return &batchUpdateUsecase{uow: uow}
+}
+
+// batchPart 検証済みのパート
+type batchPart struct {
+	name        string
+	targetTable string   // 監査イベントの対象テーブル
+	fieldNames  []string // ログ・監査イベントに記録する項目
+	err         error    // 検証エラー
+	// トランザクション内で保存し、保存後のレコードを返す
+	save func(tx repository.Tx) (interface{}, error)
+}
+
+func (u *batchUpdateUsecase) Update(c *gin.Context, userID uuid.UUID, req entity.BatchUpdateRequest) (*entity.BatchUpdateResponse, error) {
+	parts, err := prepareBatchParts(c, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]entity.BatchPartResult, len(parts))
+	for i, part := range parts {
+		results[i] = entity.BatchPartResult{Part: part.name, Status: entity.BatchPartStatusSkipped, Err: part.err}
+		if part.err != nil {
+			results[i].Status = entity.BatchPartStatusFailed
+		}
+	}
+	// 検証エラーがある場合は保存しない
+	for _, part := range parts {
+		if part.err != nil {
+			return nil, &entity.BatchError{Results: results}
+		}
+	}
+
+	err = u.uow.Do(c, func(tx repository.Tx) error {
+		for i, part := range parts {
+			saved, err := part.save(tx)
+			if err != nil {
+				results[i].Status = entity.BatchPartStatusFailed
+				results[i].Err = err
+				return err
+			}
+			results[i].Data = saved
+		}
+		return nil
+	})
+	if err != nil {
+		// ロールバックしたため、保存済みのパートも保存しなかったことにする
+		for i := range results {
+			results[i].Data = nil
+		}
+		return nil, &entity.BatchError{Results: results}
+	}
+
+	for i, part := range parts {
+		results[i].Status = entity.BatchPartStatusSaved
+		recordAuditChange(c, userID, part.targetTable, entity.RevisionSourceManual, part.fieldNames)
+	}
+	return &entity.BatchUpdateResponse{Results: results}, nil
+}
+
+// prepareBatchParts リクエストのパートを検証し、user・profile・サービス（レジストリの順）に並べる
+// 検証エラーはパートごとに設定し、全パートの結果を返せるようにする
+func prepareBatchParts(c *gin.Context, userID uuid.UUID, req entity.BatchUpdateRequest) ([]batchPart, error) {
+	var parts []batchPart
+
+	if req.User != nil {
+		updateData := userUpdateData(req.User.Data)
+		version := req.User.Version
+		parts = append(parts, batchPart{
+			name:        entity.BatchPartUser,
+			targetTable: entity.User{}.TableName(),
+			fieldNames:  sortedKeys(updateData),
+			err:         validateBatchUserData(req.User.Data),
+			save: func(tx repository.Tx) (interface{}, error) {
+				user, err := tx.Users().UpdateUser(c, userID.String(), updateData, version)
+				if err != nil {
+					return nil, userNotFound(err, userID)
+				}
+				return user, nil
+			},
+		})
+	}
+
+	if req.Profile != nil {
+		data, version := req.Profile.Data, req.Profile.Version
+		parts = append(parts, batchPart{
+			name:        entity.BatchPartProfile,
+			targetTable: entity.ProfileTargetTable,
+			fieldNames:  updatedFieldNames(data),
+			err:         validateProfileData(data),
+			save: func(tx repository.Tx) (interface{}, error) {
+				existingProfile, err := tx.Profiles().GetProfileByUserID(c, userID)
+				if err != nil {
+					return nil, err
+				}
+				if err := entity.CheckVersion(version, existingProfile); err != nil {
+					return nil, err
+				}
+				return saveProfile(c, tx, userID, mergeProfileData(existingProfile, data, userID), existingProfile, updatedFieldNames(data))
+			},
+		})
+	}
+
+	// サービスキーと日本語サービス名のどちらでも指定できるため、キーに揃えて重複を確認する
+	services := make(map[string]entity.BatchServicePart, len(req.Services))
+	for name, part := range req.Services {
+		def, exists := entity.ResolveService(name)
+		if !exists {
+			return nil, entity.NewValidationError("services."+name, "unknown service")
+		}
+		if _, duplicated := services[def.Key]; duplicated {
+			return nil, entity.NewValidationError("services."+name, "is specified more than once")
+		}
+		services[def.Key] = part
+	}
+	for _, def := range entity.Services {
+		part, exists := services[def.Key]
+		if !exists {
+			continue
+		}
+		parts = append(parts, prepareBatchServicePart(c, userID, def, part))
+	}
+
+	if len(parts) == 0 {
+		return nil, entity.ErrEmptyBatch
+	}
+	return parts, nil
+}
+
+// validateBatchUserData PUT・PATCHと同じく年齢・学年の範囲と文字数を検証
+func validateBatchUserData(data entity.UserData) error {
+	if data.Age < 0 || data.Age > 150 {
+		return entity.NewValidationError("age", "must be between 0 and 150")
+	}
+	if data.Grade != nil && (*data.Grade < 1 || *data.Grade > 10) {
+		return entity.NewValidationError("grade", "must be between 1 and 10")
+	}
+	return entity.ValidateModelSizes(&entity.User{
+		LastName:      data.LastName,
+		FirstName:     data.FirstName,
+		University:    data.University,
+		Category:      data.Category,
+		Faculty:       data.Faculty,
+		TargetJobType: data.TargetJobType,
+	})
+}
+
+// prepareBatchServicePart 就活サービスのパートを検証（PUTと同じくプロフィール全体を保存する）
+func prepareBatchServicePart(c *gin.Context, userID uuid.UUID, def entity.ServiceDefinition, part entity.BatchServicePart) batchPart {
+	prepared := batchPart{name: def.Key, targetTable: def.Key}
+	if len(part.Data) == 0 {
+		prepared.err = entity.NewValidationError("data", "is required")
+		return prepared
+	}
+	model, err := decodeServiceData(def, userID, part.Data)
+	if err != nil {
+		prepared.err = err
+		return prepared
+	}
+	if err := entity.ValidateModelSizes(model); err != nil {
+		prepared.err = err
+		return prepared
+	}
+
+	prepared.fieldNames = nonEmptyFieldKeys(def, model)
+	prepared.save = func(tx repository.Tx) (interface{}, error) {
+		before, err := tx.Services(def).GetByUserID(c, userID)
+		if err != nil {
+			return nil, err
+		}
+		if err := entity.CheckVersion(part.Version, before); err != nil {
+			return nil, err
+		}
+		// 一覧項目は配列項目から組み立て直す（既存の項目IDを引き継ぐ）
+		entity.SetListItemsFrom(model, before)
+		return saveService(c, tx, def, userID, model, before, prepared.fieldNames)
+	}
+	return prepared
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository/memory"
+)
+
+func TestBatchUpdateRollback(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          entity.BatchUpdateRequest
+		wantStatuses map[string]string
+	}{
+		{
+			name: "保存時に失敗したパートがあれば保存済みのパートも戻す",
+			req: entity.BatchUpdateRequest{
+				User: &entity.BatchUserPart{Data: entity.UserData{University: "京都大学"}},
+				Services: map[string]entity.BatchServicePart{
+					"supporterz": {Data: json.RawMessage(`{"career_vision":"CTO"}`), Version: int64Ptr(5)},
+				},
+			},
+			wantStatuses: map[string]string{
+				entity.BatchPartUser: entity.BatchPartStatusSkipped,
+				"supporterz":         entity.BatchPartStatusFailed,
+			},
+		},
+		{
+			name: "検証エラーのパートがあればどのパートも保存しない",
+			req: entity.BatchUpdateRequest{
+				User: &entity.BatchUserPart{Data: entity.UserData{University: "京都大学", Age: 200}},
+				Services: map[string]entity.BatchServicePart{
+					"supporterz": {Data: json.RawMessage(`{"career_vision":"CTO"}`)},
+				},
+			},
+			wantStatuses: map[string]string{
+				entity.BatchPartUser: entity.BatchPartStatusFailed,
+				"supporterz":         entity.BatchPartStatusSkipped,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := memory.NewRepositories()
+			userID := newTestUser(t, repos)
+			c := newTestContext(t)
+
+			_, err := NewBatchUpdateUsecase(repos.UnitOfWork).Update(c, userID, tt.req)
+			var batchErr *entity.BatchError
+			if !errors.As(err, &batchErr) {
+				t.Fatalf("Update() = %v, want *entity.BatchError", err)
+			}
+			for _, result := range batchErr.Results {
+				if result.Status != tt.wantStatuses[result.Part] {
+					t.Errorf("part %s status = %s, want %s", result.Part, result.Status, tt.wantStatuses[result.Part])
+				}
+				if result.Data != nil {
+					t.Errorf("part %s data = %v, want nil", result.Part, result.Data)
+				}
+			}
+
+			user, err := repos.Users.GetUserByID(c, userID.String())
+			if err != nil {
+				t.Fatalf("GetUserByID() = %v", err)
+			}
+			if user.University != "東京大学" || user.Version != 1 {
+				t.Errorf("user university, version = %q, %d, want unchanged", user.University, user.Version)
+			}
+			service, err := newServiceUsecase(t, repos, "supporterz").GetByUserID(c, userID)
+			if err != nil {
+				t.Fatalf("GetByUserID() = %v", err)
+			}
+			if entity.ModelVersion(service) != 0 {
+				t.Errorf("supporterz version = %d, want 0 (not saved)", entity.ModelVersion(service))
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// ErrCustomServiceNotFound 指定されたカスタムサービスが存在しない
+var ErrCustomServiceNotFound = entity.NewKindError(entity.ErrNotFound, "custom service not found")
+
+// CustomServiceUsecase ユーザー定義の就活サービスのビジネスロジック
+type CustomServiceUsecase interface {
+	GetCustomServicesByUserID(c *gin.Context, userID uuid.UUID) ([]entity.CustomService, error)
+	GetCustomServiceByID(c *gin.Context, id uuid.UUID) (*entity.CustomService, error)
+	CreateCustomService(c *gin.Context, userID uuid.UUID, req entity.CustomServiceData) (*entity.CustomService, error)
+	UpdateCustomService(c *gin.Context, id uuid.UUID, req entity.CustomServiceData) (*entity.CustomService, error)
+	DeleteCustomService(c *gin.Context, id uuid.UUID) error
+	SaveCustomServiceValues(c *gin.Context, id uuid.UUID, values map[string]interface{}) (*entity.CustomService, error)
+}
+
+type customServiceUsecase struct {
+	csr repository.CustomServiceRepository
+	uow repository.UnitOfWork
+}
+
+func NewCustomServiceUsecase(r repository.CustomServiceRepository, uow repository.UnitOfWork) CustomServiceUsecase {
+	return &customServiceUsecase{csr: r, uow: uow}
+}
+
+func (u *customServiceUsecase) GetCustomServicesByUserID(c *gin.Context, userID uuid.UUID) ([]entity.CustomService, error) {
+	return u.csr.GetCustomServicesByUserID(c, userID)
+}
+
+func (u *customServiceUsecase) GetCustomServiceByID(c *gin.Context, id uuid.UUID) (*entity.CustomService, error) {
+	customService, err := u.csr.GetCustomServiceByID(c, id)
+	if err != nil || customService == nil {
+		return customService, err
+	}
+	if err := authorizeResource(c, customService.UserID, fmt.Errorf("%w: %s", ErrCustomServiceNotFound, id)); err != nil {
+		return nil, err
+	}
+	return customService, nil
+}
+
+func (u *customServiceUsecase) CreateCustomService(c *gin.Context, userID uuid.UUID, req entity.CustomServiceData) (*entity.CustomService, error) {
+	if err := entity.ValidateCustomServiceData(req); err != nil {
+		return nil, err
+	}
+
+	customService := &entity.CustomService{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      req.Name,
+		Fields:    req.Fields,
+		Values:    entity.CustomServiceValues{},
+		UpdatedAt: time.Now(),
+	}
+
+	return u.saveDefinition(c, customService)
+}
+
+// UpdateCustomService 項目定義を更新します。定義から外れた項目の入力値は削除されます。
+func (u *customServiceUsecase) UpdateCustomService(c *gin.Context, id uuid.UUID, req entity.CustomServiceData) (*entity.CustomService, error) {
+	if err := entity.ValidateCustomServiceData(req); err != nil {
+		return nil, err
+	}
+
+	customService, err := u.findCustomService(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	customService.Name = req.Name
+	customService.Fields = req.Fields
+	customService.Values = entity.CustomServiceValues(customService.FilterValues(customService.Values))
+	customService.UpdatedAt = time.Now()
+
+	return u.saveDefinition(c, customService)
+}
+
+// 項目定義を保存し、監査イベントに記録
+func (u *customServiceUsecase) saveDefinition(c *gin.Context, customService *entity.CustomService) (*entity.CustomService, error) {
+	saved, err := u.csr.CreateOrUpdateCustomService(c, customService, customService.Version)
+	if err != nil {
+		return nil, err
+	}
+	recordAuditChange(c, customService.UserID, customService.LogTable(), entity.RevisionSourceManual, []string{"name", "fields"})
+	return saved, nil
+}
+
+func (u *customServiceUsecase) DeleteCustomService(c *gin.Context, id uuid.UUID) error {
+	customService, err := u.findCustomService(c, id)
+	if err != nil {
+		return err
+	}
+	if err := u.csr.DeleteCustomService(c, id); err != nil {
+		return err
+	}
+	recordAuditChange(c, customService.UserID, customService.LogTable(), entity.AuditSourceDelete, nil)
+	return nil
+}
+
+// SaveCustomServiceValues 入力値を項目定義に従って検証し、送信された項目のみ更新します。
+func (u *customServiceUsecase) SaveCustomServiceValues(c *gin.Context, id uuid.UUID, values map[string]interface{}) (*entity.CustomService, error) {
+	customService, err := u.findCustomService(c, id)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, err := customService.NormalizeValues(values)
+	if err != nil {
+		return nil, err
+	}
+
+	if customService.Values == nil {
+		customService.Values = entity.CustomServiceValues{}
+	}
+	for key, value := range normalized {
+		customService.Values[key] = value
+	}
+	customService.UpdatedAt = time.Now()
+
+	// 入力値の保存と、更新されたフィールドのログを同じトランザクションで記録
+	var result *entity.CustomService
+	err = u.uow.Do(c, func(tx repository.Tx) error {
+		saved, err := tx.CustomServices().CreateOrUpdateCustomService(c, customService, customService.Version)
+		if err != nil {
+			return err
+		}
+		result = saved
+		return tx.Logs().UpsertLogs(c, customService.UserID, customService.LogTable(), normalized.NonEmptyKeys())
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordAuditChange(c, customService.UserID, customService.LogTable(), entity.RevisionSourceManual, sortedKeys(normalized))
+
+	return result, nil
+}
+
+func (u *customServiceUsecase) findCustomService(c *gin.Context, id uuid.UUID) (*entity.CustomService, error) {
+	customService, err := u.csr.GetCustomServiceByID(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if customService == nil {
+		return nil, fmt.Errorf("%w: %s", ErrCustomServiceNotFound, id)
+	}
+	if err := authorizeResource(c, customService.UserID, fmt.Errorf("%w: %s", ErrCustomServiceNotFound, id)); err != nil {
+		return nil, err
+	}
+	return customService, nil
+}
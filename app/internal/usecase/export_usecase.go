@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// アーカイブ内のファイルパス
+const (
+	exportManifestPath              = "manifest.json"
+	exportUserPath                  = "user.json"
+	exportProfilePath               = "profile.json"
+	exportServiceDir                = "services/"
+	exportCustomServicesPath        = "custom_services.json"
+	exportOfferBoxPhotosPath        = "offerbox_photos.json"
+	exportOfferBoxPhotoDir          = "photos/offerbox/"
+	exportLogsPath                  = "logs.json"
+	exportAIGenerationHistoriesPath = "ai_generation_histories.json"
+	exportFieldRevisionsPath        = "field_revisions.json"
+	exportAuditEventsPath           = "audit_events.json"
+	exportMarkdownDir               = "markdown/"
+)
+
+// 画像形式ごとの拡張子
+var photoExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// ExportUsecase ユーザーの全データのエクスポート
+type ExportUsecase interface {
+	ExportUser(c *gin.Context, userID uuid.UUID) (*entity.ExportArchive, error)
+}
+
+type exportUsecase struct {
+	er repository.ExportRepository
+}
+
+func NewExportUsecase(r repository.ExportRepository) ExportUsecase {
+	return &exportUsecase{er: r}
+}
+
+// ExportUser ユーザーの全データをJSONとMarkdownにまとめたZIPアーカイブを生成します。
+// ユーザーが存在しない場合はnilを返します。
+func (u *exportUsecase) ExportUser(c *gin.Context, userID uuid.UUID) (*entity.ExportArchive, error) {
+	export, err := u.er.GetUserExport(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil {
+		return nil, nil
+	}
+
+	w := &exportWriter{buf: &bytes.Buffer{}}
+	w.zw = zip.NewWriter(w.buf)
+
+	w.writeJSON(exportUserPath, export.User)
+	if export.Profile != nil {
+		w.writeJSON(exportProfilePath, export.Profile)
+	}
+
+	// 就活サービスはレジストリ順にJSONとMarkdownを出力
+	for _, def := range entity.Services {
+		model, exists := export.Services[def.Key]
+		if !exists {
+			continue
+		}
+		w.writeJSON(exportServiceDir+def.Key+".json", model)
+
+		fields := def.Fields()
+		values := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			values[f.Key] = f.Value(model)
+		}
+		w.writeFile(exportMarkdownDir+def.Key+".md", []byte(entity.RenderServiceMarkdown(def.Metadata(), func(key string) interface{} {
+			return values[key]
+		})))
+	}
+
+	w.writeJSON(exportCustomServicesPath, export.CustomServices)
+	for _, customService := range export.CustomServices {
+		values := customService.Values
+		w.writeFile(exportMarkdownDir+"custom_services/"+customService.ID.String()+".md", []byte(entity.RenderServiceMarkdown(customService.Metadata(), func(key string) interface{} {
+			return values[key]
+		})))
+	}
+
+	w.writeJSON(exportOfferBoxPhotosPath, export.OfferBoxPhotos)
+	for _, photo := range export.OfferBoxPhotos {
+		w.writeFile(fmt.Sprintf("%s%d%s", exportOfferBoxPhotoDir, photo.Slot, photoExtensions[photo.ContentType]), photo.Data)
+	}
+
+	w.writeJSON(exportLogsPath, export.Logs)
+	w.writeJSON(exportAIGenerationHistoriesPath, export.AIGenerationHistories)
+	w.writeJSON(exportFieldRevisionsPath, export.FieldRevisions)
+	w.writeJSON(exportAuditEventsPath, export.AuditEvents)
+
+	// 最後にファイル一覧を出力
+	manifest := entity.ExportManifest{
+		FormatVersion: entity.ExportFormatVersion,
+		ExportedAt:    export.ExportedAt,
+		UserID:        userID,
+		Files:         w.files,
+	}
+	w.writeJSON(exportManifestPath, manifest)
+
+	if w.err != nil {
+		return nil, fmt.Errorf("failed to write export archive: %w", w.err)
+	}
+	if err := w.zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write export archive: %w", err)
+	}
+
+	return &entity.ExportArchive{
+		FileName: fmt.Sprintf("export-%s-%s.zip", userID, export.ExportedAt.Format("20060102150405")),
+		Data:     w.buf.Bytes(),
+	}, nil
+}
+
+// exportWriter ZIPへの書き込み（最初のエラーを保持し、以降の書き込みはスキップ）
+type exportWriter struct {
+	buf   *bytes.Buffer
+	zw    *zip.Writer
+	files []string
+	err   error
+}
+
+func (w *exportWriter) writeJSON(path string, v interface{}) {
+	if w.err != nil {
+		return
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		w.err = err
+		return
+	}
+	w.writeFile(path, data)
+}
+
+func (w *exportWriter) writeFile(path string, data []byte) {
+	if w.err != nil {
+		return
+	}
+	f, err := w.zw.Create(path)
+	if err != nil {
+		w.err = err
+		return
+	}
+	if _, err := f.Write(data); err != nil {
+		w.err = err
+		return
+	}
+	if path != exportManifestPath {
+		w.files = append(w.files, path)
+	}
+}
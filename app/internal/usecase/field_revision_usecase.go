@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// ErrFieldRevisionNotFound 指定されたリビジョンが存在しない
+var ErrFieldRevisionNotFound = entity.NewKindError(entity.ErrNotFound, "field revision not found")
+
+// FieldRevisionUsecase 項目ごとの変更履歴のビジネスロジック
+type FieldRevisionUsecase interface {
+	GetRevisions(c *gin.Context, userID uuid.UUID, targetTable, fieldName string) ([]entity.FieldRevision, error)
+	DiffRevisions(c *gin.Context, userID uuid.UUID, targetTable, fieldName string, fromID, toID uuid.UUID) (*entity.RevisionDiff, error)
+	RevertToRevision(c *gin.Context, revisionID uuid.UUID) (*entity.FieldRevision, error)
+}
+
+type fieldRevisionUsecase struct {
+	frr repository.FieldRevisionRepository
+}
+
+func NewFieldRevisionUsecase(r repository.FieldRevisionRepository) FieldRevisionUsecase {
+	return &fieldRevisionUsecase{frr: r}
+}
+
+func (u *fieldRevisionUsecase) GetRevisions(c *gin.Context, userID uuid.UUID, targetTable, fieldName string) ([]entity.FieldRevision, error) {
+	if err := validateRevisionTarget(targetTable, fieldName); err != nil {
+		return nil, err
+	}
+	return u.frr.GetRevisions(c, userID, targetTable, fieldName)
+}
+
+// DiffRevisions 2つのリビジョンの変更後の値を比較します。
+func (u *fieldRevisionUsecase) DiffRevisions(c *gin.Context, userID uuid.UUID, targetTable, fieldName string, fromID, toID uuid.UUID) (*entity.RevisionDiff, error) {
+	if err := validateRevisionTarget(targetTable, fieldName); err != nil {
+		return nil, err
+	}
+
+	from, err := u.findRevision(c, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := u.findRevision(c, toID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 別の項目のリビジョンは比較対象外
+	for _, revision := range []*entity.FieldRevision{from, to} {
+		if revision.UserID != userID || revision.TargetTable != targetTable || revision.FieldName != fieldName {
+			return nil, fmt.Errorf("%w: %s", ErrFieldRevisionNotFound, revision.ID)
+		}
+	}
+
+	return &entity.RevisionDiff{
+		From: from,
+		To:   to,
+		Ops:  entity.DiffRevisionValues(from.NewValue, to.NewValue),
+	}, nil
+}
+
+// RevertToRevision 項目をリビジョンの変更後の値に戻します。
+func (u *fieldRevisionUsecase) RevertToRevision(c *gin.Context, revisionID uuid.UUID) (*entity.FieldRevision, error) {
+	revision, err := u.findRevision(c, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 復元・復元のリビジョン・ログは同じトランザクションで書き込む
+	reverted, err := u.frr.RevertField(c, revision)
+	if err != nil {
+		return nil, err
+	}
+	recordAuditChange(c, revision.UserID, revision.TargetTable, entity.RevisionSourceRevert, []string{revision.FieldName})
+	return reverted, nil
+}
+
+func (u *fieldRevisionUsecase) findRevision(c *gin.Context, id uuid.UUID) (*entity.FieldRevision, error) {
+	revision, err := u.frr.GetRevisionByID(c, id)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		return nil, fmt.Errorf("%w: %s", ErrFieldRevisionNotFound, id)
+	}
+	if err := authorizeResource(c, revision.UserID, fmt.Errorf("%w: %s", ErrFieldRevisionNotFound, id)); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+func validateRevisionTarget(targetTable, fieldName string) error {
+	model, exists := entity.NewRevisionModel(targetTable)
+	if !exists {
+		return fmt.Errorf("%w: %s", entity.ErrInvalidRevisionTarget, targetTable)
+	}
+	if _, exists := entity.FindModelField(model, fieldName); !exists {
+		return fmt.Errorf("%w: %s.%s", entity.ErrInvalidRevisionTarget, targetTable, fieldName)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// 依存先ごとの確認のタイムアウト（Renderのヘルスチェックより十分短くする）
+const healthCheckTimeout = 3 * time.Second
+
+// LLMProvider 生成AIのプロバイダー（設定の有無とAPIへの到達性を確認する）
+type LLMProvider interface {
+	Configured() bool
+	Ping(ctx context.Context) error
+}
+
+// HealthUsecase 稼働状況の確認
+type HealthUsecase interface {
+	// DB接続・マイグレーションの適用・生成AIの設定を確認
+	Readiness(c *gin.Context) *entity.ReadinessResponse
+	// Readinessの確認に加え、DBコネクションプール・ビルド情報・外部サービスへの到達性を返す
+	Status(c *gin.Context) *entity.StatusResponse
+}
+
+type healthUsecase struct {
+	hr        repository.HealthRepository
+	llm       LLMProvider
+	build     entity.BuildInfo
+	startedAt time.Time
+}
+
+func NewHealthUsecase(r repository.HealthRepository, llm LLMProvider, build entity.BuildInfo) HealthUsecase {
+	return &healthUsecase{hr: r, llm: llm, build: build, startedAt: time.Now()}
+}
+
+func (u *healthUsecase) Readiness(c *gin.Context) *entity.ReadinessResponse {
+	checks, _ := u.readinessChecks(c)
+	return &entity.ReadinessResponse{Status: overallStatus(checks), Checks: checks}
+}
+
+func (u *healthUsecase) Status(c *gin.Context) *entity.StatusResponse {
+	checks, migrations := u.readinessChecks(c)
+	status := overallStatus(checks)
+
+	// 外部サービスに到達できなくても、AI生成以外のリクエストは処理できる
+	if u.llm.Configured() {
+		checks["gemini"] = runHealthCheck(c, func(ctx context.Context) error {
+			return u.llm.Ping(ctx)
+		})
+		if status == entity.HealthStatusOK && checks["gemini"].Status != entity.HealthStatusOK {
+			status = entity.HealthStatusDegraded
+		}
+	}
+
+	response := &entity.StatusResponse{
+		Status:        status,
+		Build:         u.build,
+		StartedAt:     u.startedAt,
+		UptimeSeconds: int64(time.Since(u.startedAt).Seconds()),
+		Checks:        checks,
+		Migrations:    migrations,
+	}
+	if stats, err := u.hr.PoolStats(); err == nil {
+		response.Database = stats
+	}
+	return response
+}
+
+// readinessChecks DB接続・マイグレーション・生成AIの設定を確認（マイグレーションの状態も返す）
+func (u *healthUsecase) readinessChecks(c *gin.Context) (map[string]entity.HealthCheck, *entity.MigrationState) {
+	checks := make(map[string]entity.HealthCheck, 4)
+
+	checks["database"] = runHealthCheck(c, u.hr.Ping)
+
+	var migrations *entity.MigrationState
+	checks["migrations"] = runHealthCheck(c, func(ctx context.Context) error {
+		state, err := u.hr.MigrationState(ctx)
+		if err != nil {
+			return err
+		}
+		migrations = state
+		if len(state.Pending) > 0 {
+			return fmt.Errorf("%d pending migration(s): %v", len(state.Pending), state.Pending)
+		}
+		return nil
+	})
+
+	checks["llm"] = entity.HealthCheck{Status: entity.HealthStatusOK}
+	if !u.llm.Configured() {
+		checks["llm"] = entity.HealthCheck{Status: entity.HealthStatusError, Error: "GEMINI_API_KEY is not configured"}
+	}
+
+	return checks, migrations
+}
+
+// runHealthCheck タイムアウト付きで確認を実行し、結果と所要時間を返す
+func runHealthCheck(c *gin.Context, check func(ctx context.Context) error) entity.HealthCheck {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check(ctx)
+	result := entity.HealthCheck{Status: entity.HealthStatusOK, LatencyMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = entity.HealthStatusError
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// overallStatus すべての確認が成功した場合のみok
+func overallStatus(checks map[string]entity.HealthCheck) string {
+	for _, check := range checks {
+		if check.Status != entity.HealthStatusOK {
+			return entity.HealthStatusError
+		}
+	}
+	return entity.HealthStatusOK
+}
@@ -0,0 +1,412 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// MaxImportArchiveBytes インポートできるアーカイブの最大サイズ
+const MaxImportArchiveBytes = 50 * 1024 * 1024
+
+// ImportUsecase エクスポートしたアーカイブのインポート
+type ImportUsecase interface {
+	// targetUserIDがnilの場合はエクスポート元のユーザーIDに復元する
+	ImportUser(c *gin.Context, archive []byte, targetUserID *uuid.UUID, dryRun bool) (*entity.ImportResult, error)
+}
+
+type importUsecase struct {
+	er repository.ExportRepository
+	ir repository.ImportRepository
+}
+
+func NewImportUsecase(er repository.ExportRepository, ir repository.ImportRepository) ImportUsecase {
+	return &importUsecase{er: er, ir: ir}
+}
+
+// ImportUser アーカイブを現在のエンティティ定義で検証し、現在のデータとの差分を返します。
+// dryRunでない場合は、ユーザー・ES・各サービスのデータを1トランザクションでupsertします。
+// 更新ログ・AI生成履歴・変更履歴・監査イベントは復元せず、件数をインポート結果のskippedで返します。
+func (u *importUsecase) ImportUser(c *gin.Context, archive []byte, targetUserID *uuid.UUID, dryRun bool) (*entity.ImportResult, error) {
+	data, manifest, skipped, err := parseExportArchive(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := manifest.UserID
+	if targetUserID != nil && *targetUserID != manifest.UserID {
+		userID = *targetUserID
+		reassignUserID(data, userID)
+	}
+	// 復元・移行先のアカウントは呼び出し元本人のもの（管理者は任意のアカウント）に限る
+	if err := authorizeOwner(c, userID); err != nil {
+		return nil, err
+	}
+
+	if err := validateImportData(data, userID); err != nil {
+		return nil, err
+	}
+
+	current, err := u.er.GetUserExport(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.ImportResult{
+		UserID:       userID,
+		SourceUserID: manifest.UserID,
+		DryRun:       dryRun,
+		Changes:      diffImport(current, data),
+		Skipped:      skipped,
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	if err := u.ir.ApplyImport(c, data); err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	for _, change := range result.AuditChanges() {
+		recordAuditChange(c, userID, change.TargetTable, change.Source, change.Fields)
+	}
+	return result, nil
+}
+
+// 復元しない履歴のファイル（インポート時の保存で記録し直すため、過去の履歴は持ち込まない）
+var importSkippedFiles = []string{
+	exportLogsPath,
+	exportAIGenerationHistoriesPath,
+	exportFieldRevisionsPath,
+	exportAuditEventsPath,
+}
+
+// アーカイブを読み込み、現在のエンティティ定義に存在しない項目があればエラーとする
+// 復元しない履歴のファイルはスキーマを確認せず、レコード数のみ返す
+func parseExportArchive(archive []byte) (*entity.UserExport, *entity.ExportManifest, []entity.ImportSkip, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("%w: not a zip archive: %v", entity.ErrInvalidImportArchive, err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		// 展開・再圧縮したアーカイブに含まれるディレクトリのエントリ（services/ など）は読み飛ばす
+		if f.FileInfo().IsDir() || strings.HasSuffix(f.Name, "/") {
+			continue
+		}
+		files[f.Name] = f
+	}
+
+	var manifest entity.ExportManifest
+	if err := decodeArchiveJSON(files, exportManifestPath, &manifest); err != nil {
+		return nil, nil, nil, err
+	}
+	if manifest.FormatVersion != entity.ExportFormatVersion {
+		return nil, nil, nil, fmt.Errorf("%w: unsupported format version %d", entity.ErrInvalidImportArchive, manifest.FormatVersion)
+	}
+
+	data := &entity.UserExport{
+		ExportedAt: manifest.ExportedAt,
+		Services:   make(map[string]interface{}),
+	}
+
+	var user entity.User
+	if err := decodeArchiveJSON(files, exportUserPath, &user); err != nil {
+		return nil, nil, nil, err
+	}
+	data.User = &user
+
+	if _, exists := files[exportProfilePath]; exists {
+		var profile entity.Profile
+		if err := decodeArchiveJSON(files, exportProfilePath, &profile); err != nil {
+			return nil, nil, nil, err
+		}
+		data.Profile = &profile
+	}
+
+	for name := range files {
+		if !strings.HasPrefix(name, exportServiceDir) {
+			continue
+		}
+		key := strings.TrimSuffix(strings.TrimPrefix(name, exportServiceDir), ".json")
+		def, exists := entity.FindService(key)
+		if !exists {
+			return nil, nil, nil, fmt.Errorf("%w: unknown service %q", entity.ErrInvalidImportArchive, key)
+		}
+		model := def.NewModel()
+		if err := decodeArchiveJSON(files, name, model); err != nil {
+			return nil, nil, nil, err
+		}
+		data.Services[def.Key] = model
+	}
+
+	if _, exists := files[exportCustomServicesPath]; exists {
+		if err := decodeArchiveJSON(files, exportCustomServicesPath, &data.CustomServices); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if _, exists := files[exportOfferBoxPhotosPath]; exists {
+		if err := decodeArchiveJSON(files, exportOfferBoxPhotosPath, &data.OfferBoxPhotos); err != nil {
+			return nil, nil, nil, err
+		}
+		for i := range data.OfferBoxPhotos {
+			photo := &data.OfferBoxPhotos[i]
+			name := exportOfferBoxPhotoDir + strconv.Itoa(photo.Slot) + photoExtensions[photo.ContentType]
+			content, err := readArchiveFile(files, name)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			photo.Data = content
+		}
+	}
+
+	skipped := make([]entity.ImportSkip, 0, len(importSkippedFiles))
+	for _, name := range importSkippedFiles {
+		if _, exists := files[name]; !exists {
+			continue
+		}
+		var records []json.RawMessage
+		if err := decodeArchiveJSON(files, name, &records); err != nil {
+			return nil, nil, nil, err
+		}
+		skipped = append(skipped, entity.ImportSkip{File: name, Records: len(records), Reason: "history is not restored"})
+	}
+
+	return data, &manifest, skipped, nil
+}
+
+func readArchiveFile(files map[string]*zip.File, name string) ([]byte, error) {
+	f, exists := files[name]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s is missing", entity.ErrInvalidImportArchive, name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to open %s: %v", entity.ErrInvalidImportArchive, name, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, MaxImportArchiveBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read %s: %v", entity.ErrInvalidImportArchive, name, err)
+	}
+	if len(content) > MaxImportArchiveBytes {
+		return nil, fmt.Errorf("%w: %s is too large", entity.ErrInvalidImportArchive, name)
+	}
+	return content, nil
+}
+
+func decodeArchiveJSON(files map[string]*zip.File, name string, v interface{}) error {
+	content, err := readArchiveFile(files, name)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%w: %s does not match the current schema: %v", entity.ErrInvalidImportArchive, path.Base(name), err)
+	}
+	return nil
+}
+
+// 別アカウントへの移行時にユーザーIDを付け替える（カスタムサービスは新しいIDで作成）
+func reassignUserID(data *entity.UserExport, userID uuid.UUID) {
+	data.User.UserID = userID
+	if data.Profile != nil {
+		data.Profile.ID = userID
+	}
+	for _, model := range data.Services {
+		entity.SetModelID(model, userID)
+	}
+	for i := range data.CustomServices {
+		data.CustomServices[i].ID = uuid.New()
+		data.CustomServices[i].UserID = userID
+	}
+	for i := range data.OfferBoxPhotos {
+		data.OfferBoxPhotos[i].UserID = userID
+	}
+}
+
+// インポートするデータを現在のエンティティ定義で検証
+func validateImportData(data *entity.UserExport, userID uuid.UUID) error {
+	if data.User.UserID != userID {
+		return fmt.Errorf("%w: user_id in user.json does not match the manifest", entity.ErrInvalidImportArchive)
+	}
+	if err := entity.ValidateModelSizes(data.User); err != nil {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidImportArchive, err)
+	}
+
+	if data.Profile != nil {
+		if data.Profile.ID != userID {
+			return fmt.Errorf("%w: profile belongs to another user", entity.ErrInvalidImportArchive)
+		}
+		if err := entity.ValidateModelSizes(data.Profile); err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidImportArchive, err)
+		}
+	}
+
+	for key, model := range data.Services {
+		if reflect.ValueOf(model).Elem().FieldByName("ID").Interface() != userID {
+			return fmt.Errorf("%w: %s belongs to another user", entity.ErrInvalidImportArchive, key)
+		}
+		if err := entity.ValidateModelSizes(model); err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidImportArchive, err)
+		}
+	}
+
+	for i := range data.CustomServices {
+		customService := &data.CustomServices[i]
+		if customService.UserID != userID {
+			return fmt.Errorf("%w: custom service %s belongs to another user", entity.ErrInvalidImportArchive, customService.ID)
+		}
+		if err := entity.ValidateCustomServiceData(entity.CustomServiceData{Name: customService.Name, Fields: customService.Fields}); err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidImportArchive, err)
+		}
+		if _, err := customService.NormalizeValues(customService.Values); err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidImportArchive, err)
+		}
+	}
+
+	for _, photo := range data.OfferBoxPhotos {
+		if photo.UserID != userID {
+			return fmt.Errorf("%w: offerbox photo %d belongs to another user", entity.ErrInvalidImportArchive, photo.Slot)
+		}
+		if photo.Slot < 1 || photo.Slot > entity.MaxOfferBoxPhotos {
+			return fmt.Errorf("%w: invalid offerbox photo slot %d", entity.ErrInvalidImportArchive, photo.Slot)
+		}
+		if len(photo.Data) > entity.MaxOfferBoxPhotoBytes || !entity.OfferBoxPhotoContentTypes[http.DetectContentType(photo.Data)] {
+			return fmt.Errorf("%w: invalid offerbox photo %d", entity.ErrInvalidImportArchive, photo.Slot)
+		}
+	}
+	return nil
+}
+
+// 現在のデータとインポートするデータの差分をレコード単位で生成
+func diffImport(current, data *entity.UserExport) []entity.ImportChange {
+	if current == nil {
+		current = &entity.UserExport{Services: map[string]interface{}{}}
+	}
+
+	var changes []entity.ImportChange
+	add := func(table, key string, before, after interface{}) {
+		changes = append(changes, diffRecord(table, key, before, after))
+	}
+
+	add("users", data.User.UserID.String(), nilIfEmpty(current.User), data.User)
+	if data.Profile != nil {
+		add("profiles", data.Profile.ID.String(), nilIfEmpty(current.Profile), data.Profile)
+	}
+	for _, def := range entity.Services {
+		if model, exists := data.Services[def.Key]; exists {
+			add(def.Key, data.User.UserID.String(), current.Services[def.Key], model)
+		}
+	}
+
+	currentCustomServices := make(map[uuid.UUID]*entity.CustomService, len(current.CustomServices))
+	for i := range current.CustomServices {
+		currentCustomServices[current.CustomServices[i].ID] = &current.CustomServices[i]
+	}
+	for i := range data.CustomServices {
+		customService := &data.CustomServices[i]
+		add("custom_services", customService.ID.String(), nilIfEmpty(currentCustomServices[customService.ID]), customService)
+	}
+
+	currentPhotos := make(map[int]*entity.OfferBoxPhoto, len(current.OfferBoxPhotos))
+	for i := range current.OfferBoxPhotos {
+		currentPhotos[current.OfferBoxPhotos[i].Slot] = &current.OfferBoxPhotos[i]
+	}
+	for i := range data.OfferBoxPhotos {
+		photo := &data.OfferBoxPhotos[i]
+		before := currentPhotos[photo.Slot]
+		change := diffRecord("offerbox_photos", strconv.Itoa(photo.Slot), nilIfEmpty(before), photo)
+		// 画像データはJSONに含まれないため個別に比較
+		if before != nil && !bytes.Equal(before.Data, photo.Data) {
+			change.Action = entity.ImportActionUpdate
+			change.Fields = append(change.Fields, entity.ImportFieldDiff{
+				Field:  "data",
+				Before: fmt.Sprintf("%d bytes", len(before.Data)),
+				After:  fmt.Sprintf("%d bytes", len(photo.Data)),
+			})
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// 型付きnilポインタをnilに変換（差分生成時の存在判定用）
+func nilIfEmpty(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	return v
+}
+
+// JSON表現で項目ごとに比較
+func diffRecord(table, key string, before, after interface{}) entity.ImportChange {
+	change := entity.ImportChange{Table: table, Key: key, Action: entity.ImportActionUnchanged}
+
+	afterMap := toJSONMap(after)
+	beforeMap := map[string]interface{}{}
+	if before == nil {
+		change.Action = entity.ImportActionCreate
+	} else {
+		beforeMap = toJSONMap(before)
+	}
+
+	fieldNames := make([]string, 0, len(afterMap))
+	for field := range afterMap {
+		// バージョンは保存時に現在の値から採番し直し、一覧項目は配列項目の差分に含まれるため比較しない
+		if field == entity.VersionKey || field == entity.ListItemsKey {
+			continue
+		}
+		fieldNames = append(fieldNames, field)
+	}
+	sort.Strings(fieldNames)
+
+	for _, field := range fieldNames {
+		beforeValue, existed := beforeMap[field]
+		if existed && reflect.DeepEqual(beforeValue, afterMap[field]) {
+			continue
+		}
+		change.Fields = append(change.Fields, entity.ImportFieldDiff{
+			Field:  field,
+			Before: beforeValue,
+			After:  afterMap[field],
+		})
+	}
+
+	if change.Action != entity.ImportActionCreate && len(change.Fields) > 0 {
+		change.Action = entity.ImportActionUpdate
+	}
+	return change
+}
+
+func toJSONMap(v interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	content, err := json.Marshal(v)
+	if err != nil {
+		return result
+	}
+	_ = json.Unmarshal(content, &result)
+	return result
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository/memory"
+)
+
+func TestImportUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		dryRun      bool
+		wantApplied bool
+	}{
+		{name: "dry-runは差分のみ返して保存しない", dryRun: true, wantApplied: false},
+		{name: "dry-runでない場合は保存する", dryRun: false, wantApplied: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := memory.NewRepositories()
+			sourceID := newTestUser(t, repos)
+			c := newTestContext(t)
+			services := newServiceUsecase(t, repos, "supporterz")
+			if _, err := services.CreateOrUpdate(c, sourceID, json.RawMessage(`{"career_vision":"CTO"}`), nil); err != nil {
+				t.Fatalf("CreateOrUpdate() = %v", err)
+			}
+			archive, err := NewExportUsecase(repos.Export).ExportUser(c, sourceID)
+			if err != nil {
+				t.Fatalf("ExportUser() = %v", err)
+			}
+
+			// 別のユーザーIDに移行する
+			targetID := uuid.New()
+			result, err := NewImportUsecase(repos.Export, repos.Import).ImportUser(c, archive.Data, &targetID, tt.dryRun)
+			if err != nil {
+				t.Fatalf("ImportUser() = %v", err)
+			}
+			if result.UserID != targetID || result.SourceUserID != sourceID {
+				t.Errorf("user_id, source_user_id = %s, %s, want %s, %s", result.UserID, result.SourceUserID, targetID, sourceID)
+			}
+			if result.DryRun != tt.dryRun || result.Applied != tt.wantApplied {
+				t.Errorf("dry_run, applied = %v, %v, want %v, %v", result.DryRun, result.Applied, tt.dryRun, tt.wantApplied)
+			}
+			created := map[string]bool{}
+			for _, change := range result.Changes {
+				if change.Action == entity.ImportActionCreate {
+					created[change.Table] = true
+				}
+			}
+			for _, table := range []string{entity.User{}.TableName(), "supporterz"} {
+				if !created[table] {
+					t.Errorf("changes have no create for %s: %+v", table, result.Changes)
+				}
+			}
+
+			// 変更履歴はアーカイブに含まれるが復元しない
+			skipped := map[string]int{}
+			for _, skip := range result.Skipped {
+				skipped[skip.File] = skip.Records
+			}
+			if skipped[exportFieldRevisionsPath] == 0 {
+				t.Errorf("skipped = %+v, want %s with records", result.Skipped, exportFieldRevisionsPath)
+			}
+			if _, exists := skipped[exportAuditEventsPath]; !exists {
+				t.Errorf("skipped = %+v, want %s", result.Skipped, exportAuditEventsPath)
+			}
+			revisions, err := repos.FieldRevisions.GetRevisions(c, targetID, "supporterz", "career_vision")
+			if err != nil {
+				t.Fatalf("GetRevisions() = %v", err)
+			}
+			if len(revisions) != 0 {
+				t.Errorf("target revisions = %d, want 0 (not restored)", len(revisions))
+			}
+
+			_, err = repos.Users.GetUserByID(c, targetID.String())
+			if exists := err == nil; exists != tt.wantApplied {
+				t.Errorf("target user exists = %v, want %v", exists, tt.wantApplied)
+			}
+			service, err := services.GetByUserID(c, targetID)
+			if err != nil {
+				t.Fatalf("GetByUserID() = %v", err)
+			}
+			if saved := entity.ModelVersion(service) != 0; saved != tt.wantApplied {
+				t.Errorf("target supporterz saved = %v, want %v", saved, tt.wantApplied)
+			}
+		})
+	}
+}
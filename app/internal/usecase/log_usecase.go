@@ -1,7 +1,6 @@
 package usecase
 
 import (
-	"log"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,9 +13,8 @@ import (
 var JST = time.FixedZone("JST", 9*60*60)
 
 type LogUsecase interface {
-	GetLogsByUserID(c *gin.Context, userID uuid.UUID) (entity.LogResponse, error)
-	UpsertLog(userID uuid.UUID, targetTable, fieldName string) error
-	LogFieldUpdateWithErrorHandling(userID uuid.UUID, targetTable, fieldName string)
+	// 絞り込み条件に一致するログを、サービスごとのマップと時系列の一覧で取得
+	GetLogs(c *gin.Context, userID uuid.UUID, filter entity.LogFilter) (*entity.LogList, error)
 }
 
 type logUsecase struct {
@@ -27,32 +25,39 @@ func NewLogUsecase(r repository.LogRepository) LogUsecase {
 	return &logUsecase{lr: r}
 }
 
-func (u *logUsecase) GetLogsByUserID(c *gin.Context, userID uuid.UUID) (entity.LogResponse, error) {
-	logs, err := u.lr.GetLogsByUserID(c, userID)
+func (u *logUsecase) GetLogs(c *gin.Context, userID uuid.UUID, filter entity.LogFilter) (*entity.LogList, error) {
+	if err := entity.ValidateLogFilter(&filter); err != nil {
+		return nil, err
+	}
+	// サービスは日本語サービス名でも指定できる（ログの対象テーブルはサービスキー）
+	for i, table := range filter.TargetTables {
+		if def, exists := entity.FindServiceByDisplayName(table); exists {
+			filter.TargetTables[i] = def.Key
+		}
+	}
+
+	logs, total, err := u.lr.GetLogs(c, userID, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	logMap := make(map[string]map[string]time.Time)
+	list := &entity.LogList{
+		Total:    total,
+		Logs:     make(entity.LogResponse),
+		Timeline: make([]entity.LogEntry, 0, len(logs)),
+	}
 	for _, logEntry := range logs {
-		if logMap[logEntry.TargetTable] == nil {
-			logMap[logEntry.TargetTable] = make(map[string]time.Time)
+		if list.Logs[logEntry.TargetTable] == nil {
+			list.Logs[logEntry.TargetTable] = make(map[string]time.Time)
 		}
 		jstTime := logEntry.UpdatedAt.In(JST)
-		logMap[logEntry.TargetTable][logEntry.FieldName] = jstTime
+		list.Logs[logEntry.TargetTable][logEntry.FieldName] = jstTime
+		list.Timeline = append(list.Timeline, entity.LogEntry{
+			TargetTable: logEntry.TargetTable,
+			FieldName:   logEntry.FieldName,
+			UpdatedAt:   jstTime,
+		})
 	}
 
-	return logMap, nil
-}
-
-func (u *logUsecase) UpsertLog(userID uuid.UUID, targetTable, fieldName string) error {
-	return u.lr.UpsertLog(nil, userID, targetTable, fieldName)
-}
-
-// LogFieldUpdateWithErrorHandling logs field update with error handling
-func (u *logUsecase) LogFieldUpdateWithErrorHandling(userID uuid.UUID, targetTable, fieldName string) {
-	err := u.lr.UpsertLog(nil, userID, targetTable, fieldName)
-	if err != nil {
-		log.Printf("Failed to log field update for table %s, field %s: %v", targetTable, fieldName, err)
-	}
+	return list, nil
 }
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+var (
+	// ErrInvalidOfferBoxPhoto アップロードされた写真または写真枠の番号が不正
+	ErrInvalidOfferBoxPhoto = entity.NewKindError(entity.ErrValidation, "invalid offerbox photo")
+	// ErrOfferBoxPhotoNotFound 指定された写真枠に写真が登録されていない
+	ErrOfferBoxPhotoNotFound = entity.NewKindError(entity.ErrNotFound, "offerbox photo not found")
+)
+
+// offerBoxPhotoLogField 写真の更新を記録するlogsテーブルのfield_name
+const offerBoxPhotoLogField = "photos"
+
+// OfferBoxPhotoUsecase OfferBoxの「私を表す写真」のビジネスロジック
+type OfferBoxPhotoUsecase interface {
+	GetPhotoSlots(c *gin.Context, userID uuid.UUID) ([]entity.OfferBoxPhotoSlot, error)
+	GetPhoto(c *gin.Context, userID uuid.UUID, slot int) (*entity.OfferBoxPhoto, error)
+	SavePhoto(c *gin.Context, userID uuid.UUID, slot int, data []byte) (*entity.OfferBoxPhoto, error)
+	DeletePhoto(c *gin.Context, userID uuid.UUID, slot int) error
+}
+
+type offerBoxPhotoUsecase struct {
+	opr repository.OfferBoxPhotoRepository
+	uow repository.UnitOfWork
+}
+
+func NewOfferBoxPhotoUsecase(r repository.OfferBoxPhotoRepository, uow repository.UnitOfWork) OfferBoxPhotoUsecase {
+	return &offerBoxPhotoUsecase{opr: r, uow: uow}
+}
+
+// GetPhotoSlots 全写真枠について、画像の有無とキャプションをまとめて返します。
+func (u *offerBoxPhotoUsecase) GetPhotoSlots(c *gin.Context, userID uuid.UUID) ([]entity.OfferBoxPhotoSlot, error) {
+	photos, err := u.opr.GetPhotosByUserID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	captions, err := u.opr.GetPhotoCaptions(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make([]entity.OfferBoxPhotoSlot, entity.MaxOfferBoxPhotos)
+	for i := range slots {
+		slots[i].Slot = i + 1
+		if i < len(captions) {
+			slots[i].Caption = captions[i]
+		}
+	}
+	for _, photo := range photos {
+		if photo.Slot < 1 || photo.Slot > entity.MaxOfferBoxPhotos {
+			continue
+		}
+		slot := &slots[photo.Slot-1]
+		updatedAt := photo.UpdatedAt
+		slot.HasPhoto = true
+		slot.ContentType = photo.ContentType
+		slot.Size = photo.Size
+		slot.URL = fmt.Sprintf("/api/offerbox/%s/photos/%d", userID, photo.Slot)
+		slot.UpdatedAt = &updatedAt
+	}
+	return slots, nil
+}
+
+func (u *offerBoxPhotoUsecase) GetPhoto(c *gin.Context, userID uuid.UUID, slot int) (*entity.OfferBoxPhoto, error) {
+	if err := validateOfferBoxPhotoSlot(slot); err != nil {
+		return nil, err
+	}
+	photo, err := u.opr.GetPhoto(c, userID, slot)
+	if err != nil {
+		return nil, err
+	}
+	if photo == nil {
+		return nil, fmt.Errorf("%w: slot %d", ErrOfferBoxPhotoNotFound, slot)
+	}
+	return photo, nil
+}
+
+// SavePhoto 画像形式とサイズを検証して写真枠に保存します。既存の写真は置き換えられます。
+func (u *offerBoxPhotoUsecase) SavePhoto(c *gin.Context, userID uuid.UUID, slot int, data []byte) (*entity.OfferBoxPhoto, error) {
+	if err := validateOfferBoxPhotoSlot(slot); err != nil {
+		return nil, err
+	}
+	if len(data) == 0 || len(data) > entity.MaxOfferBoxPhotoBytes {
+		return nil, fmt.Errorf("%w: photo must be 1-%d bytes", ErrInvalidOfferBoxPhoto, entity.MaxOfferBoxPhotoBytes)
+	}
+
+	// 申告されたContent-Typeではなく、ファイルの中身から画像形式を判定
+	contentType := http.DetectContentType(data)
+	if !entity.OfferBoxPhotoContentTypes[contentType] {
+		return nil, fmt.Errorf("%w: unsupported content type %s", ErrInvalidOfferBoxPhoto, contentType)
+	}
+
+	// 写真の保存とログの更新を同じトランザクションで書き込む
+	var photo *entity.OfferBoxPhoto
+	err := u.uow.Do(c, func(tx repository.Tx) error {
+		saved, err := tx.OfferBoxPhotos().SavePhoto(c, &entity.OfferBoxPhoto{
+			UserID:      userID,
+			Slot:        slot,
+			ContentType: contentType,
+			Data:        data,
+			Size:        len(data),
+			UpdatedAt:   time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		photo = saved
+		return tx.Logs().UpsertLog(c, userID, "offerbox", offerBoxPhotoLogField)
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordAuditChange(c, userID, "offerbox", entity.RevisionSourceManual, []string{offerBoxPhotoLogField})
+
+	return photo, nil
+}
+
+func (u *offerBoxPhotoUsecase) DeletePhoto(c *gin.Context, userID uuid.UUID, slot int) error {
+	if _, err := u.GetPhoto(c, userID, slot); err != nil {
+		return err
+	}
+	// 写真の削除とログの更新を同じトランザクションで書き込む
+	err := u.uow.Do(c, func(tx repository.Tx) error {
+		if err := tx.OfferBoxPhotos().DeletePhoto(c, userID, slot); err != nil {
+			return err
+		}
+		return tx.Logs().UpsertLog(c, userID, "offerbox", offerBoxPhotoLogField)
+	})
+	if err != nil {
+		return err
+	}
+	recordAuditChange(c, userID, "offerbox", entity.AuditSourceDelete, []string{offerBoxPhotoLogField})
+	return nil
+}
+
+func validateOfferBoxPhotoSlot(slot int) error {
+	if slot < 1 || slot > entity.MaxOfferBoxPhotos {
+		return fmt.Errorf("%w: slot must be 1-%d", ErrInvalidOfferBoxPhoto, entity.MaxOfferBoxPhotos)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -12,19 +14,31 @@ import (
 )
 
 // ProfileUsecase はプロフィール関連のビジネスロジックを定義するインターフェースです。
+// ErrProfileNotFound 指定されたユーザーのプロフィール（ES）が存在しない
+var ErrProfileNotFound = entity.NewKindError(entity.ErrNotFound, "profile not found")
+
 type ProfileUsecase interface {
 	GetProfileByUserID(c *gin.Context, userID uuid.UUID) (*entity.Profile, error)
-	CreateOrUpdateProfile(c *gin.Context, userID uuid.UUID, req entity.ProfileData) (*entity.Profile, error)
+	// expectedVersionはIf-Matchで指定されたバージョン（nilの場合は無条件）
+	CreateOrUpdateProfile(c *gin.Context, userID uuid.UUID, req entity.ProfileData, expectedVersion *int64) (*entity.Profile, error)
+	// JSON Merge Patch（RFC 7396）で部分更新（キーがない項目はそのまま、nullの項目はクリア）
+	PatchProfile(c *gin.Context, userID uuid.UUID, patch []byte, expectedVersion *int64) (*entity.Profile, error)
+
+	// 一覧項目（製作物とその説明など）の追加・更新・削除・並べ替え（listKeyは主となる配列項目のキー）
+	AddListItem(c *gin.Context, userID uuid.UUID, listKey string, values map[string]string, position *int, expectedVersion *int64) (*entity.Profile, error)
+	UpdateListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, values map[string]string, expectedVersion *int64) (*entity.Profile, error)
+	DeleteListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, expectedVersion *int64) (*entity.Profile, error)
+	ReorderListItems(c *gin.Context, userID uuid.UUID, listKey string, itemIDs []uuid.UUID, expectedVersion *int64) (*entity.Profile, error)
 }
 
 type profileUsecase struct {
-	pr repository.ProfileRepository
-	lu LogUsecase
+	pr  repository.ProfileRepository
+	uow repository.UnitOfWork
 }
 
 // NewProfileUsecase は新しいProfileUsecaseのインスタンスを作成します。
-func NewProfileUsecase(r repository.ProfileRepository, l LogUsecase) ProfileUsecase {
-	return &profileUsecase{pr: r, lu: l}
+func NewProfileUsecase(r repository.ProfileRepository, uow repository.UnitOfWork) ProfileUsecase {
+	return &profileUsecase{pr: r, uow: uow}
 }
 
 // GetProfileByUserID はユーザーIDに基づいてプロフィール情報を取得します。
@@ -38,10 +52,10 @@ func (u *profileUsecase) GetProfileByUserID(c *gin.Context, userID uuid.UUID) (*
 
 // CreateOrUpdateProfile はプロフィール情報をDBに登録または更新します。
 // 空のフィールドが含まれている場合は、既存の値を保持し、空でないフィールドのみを更新します。
-func (u *profileUsecase) CreateOrUpdateProfile(c *gin.Context, userID uuid.UUID, req entity.ProfileData) (*entity.Profile, error) {
+func (u *profileUsecase) CreateOrUpdateProfile(c *gin.Context, userID uuid.UUID, req entity.ProfileData, expectedVersion *int64) (*entity.Profile, error) {
 	// リクエストデータの基本検証
 	if err := validateProfileData(req); err != nil {
-		return nil, fmt.Errorf("validation failed: %w", err)
+		return nil, err
 	}
 
 	// 既存のプロフィールを取得（存在しない場合は新規作成）
@@ -51,58 +65,199 @@ func (u *profileUsecase) CreateOrUpdateProfile(c *gin.Context, userID uuid.UUID,
 		existingProfile = nil
 	}
 
+	if err := entity.CheckVersion(expectedVersion, existingProfile); err != nil {
+		return nil, err
+	}
+
 	// 部分更新ロジック：空でないフィールドのみを更新
-	profile := u.mergeProfileData(existingProfile, req, userID)
+	profile := mergeProfileData(existingProfile, req, userID)
 
-	// プロフィールを保存
-	result, err := u.pr.CreateOrUpdateProfile(c, profile)
+	// プロフィールを保存し、更新されたフィールドのログと変更履歴を記録
+	result, err := u.save(c, userID, profile, existingProfile, updatedFieldNames(req))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create or update profile: %w", err)
 	}
 
-	// 更新されたフィールドのログを記録（エラーは無視）
-	u.logFieldUpdates(userID, req)
+	return result, nil
+}
+
+// PatchProfile はJSON Merge Patchでプロフィール情報を部分更新します。
+// CreateOrUpdateProfileと異なり、nullを指定したフィールドは空にクリアされます。
+func (u *profileUsecase) PatchProfile(c *gin.Context, userID uuid.UUID, patch []byte, expectedVersion *int64) (*entity.Profile, error) {
+	existingProfile, err := u.pr.GetProfileByUserID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckVersion(expectedVersion, existingProfile); err != nil {
+		return nil, err
+	}
+
+	// プロフィールが存在しない場合は空のプロフィールにパッチを適用して作成
+	base := existingProfile
+	if base == nil {
+		base = &entity.Profile{ID: userID}
+	}
+	patched, err := entity.ApplyMergePatch(base, patch)
+	if err != nil {
+		return nil, err
+	}
+	profile := patched.(*entity.Profile)
+	if err := entity.ValidateModelSizes(profile); err != nil {
+		return nil, err
+	}
+	// 一覧項目は配列項目から組み立て直す（既存の項目IDを引き継ぎ、パッチのitemsは使わない）
+	entity.SetListItemsFrom(profile, existingProfile)
+
+	changed := entity.ChangedFields(existingProfile, profile)
+	if existingProfile != nil && len(changed) == 0 {
+		// 変更がない場合は保存せず、バージョンも進めない
+		return existingProfile, nil
+	}
+
+	// 実際に変更されたフィールドのみログを記録し、変更履歴を残す
+	result, err := u.save(c, userID, profile, existingProfile, changed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch profile: %w", err)
+	}
 
 	return result, nil
 }
 
-// validateProfileData はプロフィールデータの基本的なバリデーションを行います
-func validateProfileData(req entity.ProfileData) error {
-	// 必須フィールドのチェック（必要に応じて追加）
-	// 現在は基本的なチェックのみ実装
+// AddListItem は一覧に項目を追加します（プロフィールが存在しない場合は作成）。
+func (u *profileUsecase) AddListItem(c *gin.Context, userID uuid.UUID, listKey string, values map[string]string, position *int, expectedVersion *int64) (*entity.Profile, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, true, func(profile *entity.Profile) error {
+		_, err := entity.AddListItem(profile, listKey, values, position)
+		return err
+	})
+}
+
+// UpdateListItem は一覧の項目の値を更新します。
+func (u *profileUsecase) UpdateListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, values map[string]string, expectedVersion *int64) (*entity.Profile, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, false, func(profile *entity.Profile) error {
+		_, err := entity.UpdateListItem(profile, listKey, itemID, values)
+		return err
+	})
+}
+
+// DeleteListItem は一覧から項目を削除します。
+func (u *profileUsecase) DeleteListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, expectedVersion *int64) (*entity.Profile, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, false, func(profile *entity.Profile) error {
+		return entity.DeleteListItem(profile, listKey, itemID)
+	})
+}
 
-	// 文字列の長さチェック
-	if len(req.CareerVision) > 2000 {
-		return fmt.Errorf("career_vision exceeds maximum length of 2000 characters")
+// ReorderListItems は一覧の項目を指定されたIDの順に並べ替えます。
+func (u *profileUsecase) ReorderListItems(c *gin.Context, userID uuid.UUID, listKey string, itemIDs []uuid.UUID, expectedVersion *int64) (*entity.Profile, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, false, func(profile *entity.Profile) error {
+		return entity.ReorderListItems(profile, listKey, itemIDs)
+	})
+}
+
+// editListItems は保存済みのプロフィールの一覧項目を操作して保存します（createIfMissingがtrueの場合はプロフィールがなければ作成）。
+func (u *profileUsecase) editListItems(c *gin.Context, userID uuid.UUID, listKey string, expectedVersion *int64, createIfMissing bool, edit func(profile *entity.Profile) error) (*entity.Profile, error) {
+	group, exists := entity.FindListGroup(&entity.Profile{}, listKey)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s has no list %s", entity.ErrListItemNotFound, entity.ProfileTargetTable, listKey)
 	}
-	if len(req.SelfPromotion) > 5000 {
-		return fmt.Errorf("self_promotion exceeds maximum length of 5000 characters")
+
+	existingProfile, err := u.pr.GetProfileByUserID(c, userID)
+	if err != nil {
+		return nil, err
 	}
-	if len(req.StudentExperience) > 5000 {
-		return fmt.Errorf("student_experience exceeds maximum length of 5000 characters")
+	if err := entity.CheckVersion(expectedVersion, existingProfile); err != nil {
+		return nil, err
 	}
-	if len(req.Research) > 2000 {
-		return fmt.Errorf("research exceeds maximum length of 2000 characters")
+
+	var profile *entity.Profile
+	switch {
+	case existingProfile != nil:
+		profile = entity.CloneModel(existingProfile).(*entity.Profile)
+	case createIfMissing:
+		profile = &entity.Profile{ID: userID}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrProfileNotFound, userID)
 	}
-	if len(req.Organization) > 2000 {
-		return fmt.Errorf("organization exceeds maximum length of 2000 characters")
+
+	if err := edit(profile); err != nil {
+		return nil, err
 	}
-	if len(req.DesiredJobType) > 2000 {
-		return fmt.Errorf("desired_job_type exceeds maximum length of 2000 characters")
+
+	// 一覧を構成する項目のログを記録
+	fieldNames := make([]string, 0, len(group.Fields))
+	for _, field := range group.Fields {
+		fieldNames = append(fieldNames, field.Key)
 	}
-	if len(req.CompanySelectionCriteria) > 2000 {
-		return fmt.Errorf("company_selection_criteria exceeds maximum length of 2000 characters")
+	return u.save(c, userID, profile, existingProfile, fieldNames)
+}
+
+// save はプロフィールを保存し、変更履歴とログを同じトランザクションで記録します。
+// 読み込み後に他の書き込みがあった場合は、現在の値と共に競合を返します。
+func (u *profileUsecase) save(c *gin.Context, userID uuid.UUID, profile, existingProfile *entity.Profile, fieldNames []string) (*entity.Profile, error) {
+	var result *entity.Profile
+	err := u.uow.Do(c, func(tx repository.Tx) error {
+		saved, err := saveProfile(c, tx, userID, profile, existingProfile, fieldNames)
+		result = saved
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrVersionConflict) {
+			current, _ := u.pr.GetProfileByUserID(c, userID)
+			return nil, &entity.ConflictError{Err: err, Current: current}
+		}
+		return nil, err
 	}
-	if len(req.EngineerAspiration) > 2000 {
-		return fmt.Errorf("engineer_aspiration exceeds maximum length of 2000 characters")
+	recordAuditChange(c, userID, entity.ProfileTargetTable, entity.RevisionSourceManual, fieldNames)
+	return result, nil
+}
+
+// saveProfile はトランザクション内でプロフィールを保存し、変更履歴とログを記録します（バッチ更新と共通）。
+func saveProfile(ctx context.Context, tx repository.Tx, userID uuid.UUID, profile, existingProfile *entity.Profile, fieldNames []string) (*entity.Profile, error) {
+	saved, err := tx.Profiles().CreateOrUpdateProfile(ctx, profile, entity.ModelVersion(existingProfile))
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.RecordChanges(ctx, userID, entity.ProfileTargetTable, entity.RevisionSourceManual, existingProfile, profile, fieldNames); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// validateProfileData はプロフィールデータの基本的なバリデーションを行います
+// 文字数を超えた項目をまとめて項目ごとの検証エラーとして返します
+func validateProfileData(req entity.ProfileData) error {
+	limits := []struct {
+		field     string
+		value     string
+		maxLength int
+	}{
+		{"career_vision", req.CareerVision, 2000},
+		{"self_promotion", req.SelfPromotion, 5000},
+		{"student_experience", req.StudentExperience, 5000},
+		{"research", req.Research, 2000},
+		{"organization", req.Organization, 2000},
+		{"desired_job_type", req.DesiredJobType, 2000},
+		{"company_selection_criteria", req.CompanySelectionCriteria, 2000},
+		{"engineer_aspiration", req.EngineerAspiration, 2000},
 	}
 
+	validation := &entity.ValidationError{}
+	for _, limit := range limits {
+		if len(limit.value) > limit.maxLength {
+			validation.Fields = append(validation.Fields, entity.FieldError{
+				Field:   "data." + limit.field,
+				Message: fmt.Sprintf("exceeds maximum length of %d characters", limit.maxLength),
+			})
+		}
+	}
+	if len(validation.Fields) > 0 {
+		return validation
+	}
 	return nil
 }
 
 // mergeProfileData は既存のプロフィールデータと新しいリクエストデータをマージします。
 // 空でないフィールドのみを更新し、空のフィールドは既存の値を保持します。
-func (u *profileUsecase) mergeProfileData(existing *entity.Profile, req entity.ProfileData, userID uuid.UUID) *entity.Profile {
+func mergeProfileData(existing *entity.Profile, req entity.ProfileData, userID uuid.UUID) *entity.Profile {
 	var result *entity.Profile
 
 	if existing == nil {
@@ -130,6 +285,7 @@ func (u *profileUsecase) mergeProfileData(existing *entity.Profile, req entity.P
 			DesiredJobType:            existing.DesiredJobType,
 			CompanySelectionCriteria:  existing.CompanySelectionCriteria,
 			EngineerAspiration:        existing.EngineerAspiration,
+			Items:                     existing.Items, // 一覧項目のIDを引き継ぐ（保存時に配列項目から組み立て直す）
 		}
 	}
 
@@ -188,57 +344,59 @@ func (u *profileUsecase) mergeProfileData(existing *entity.Profile, req entity.P
 	return result
 }
 
-// logFieldUpdates は更新されたフィールドのログを記録します。
-func (u *profileUsecase) logFieldUpdates(userID uuid.UUID, req entity.ProfileData) {
-	targetTable := "profiles"
+// updatedFieldNames はログを記録するフィールド（リクエストで空でないフィールド）を返します。
+func updatedFieldNames(req entity.ProfileData) []string {
+	fieldNames := make([]string, 0)
 
 	// 各フィールドが空でなければログを記録
 	if req.CareerVision != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "career_vision")
+		fieldNames = append(fieldNames, "career_vision")
 	}
 	if req.SelfPromotion != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "self_promotion")
+		fieldNames = append(fieldNames, "self_promotion")
 	}
 	if req.StudentExperience != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "student_experience")
+		fieldNames = append(fieldNames, "student_experience")
 	}
 	if req.Research != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "research")
+		fieldNames = append(fieldNames, "research")
 	}
 	if len(req.Products) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "products")
+		fieldNames = append(fieldNames, "products")
 	}
 	if len(req.ProductDescriptions) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "product_descriptions")
+		fieldNames = append(fieldNames, "product_descriptions")
 	}
 	if len(req.Skills) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "skills")
+		fieldNames = append(fieldNames, "skills")
 	}
 	if len(req.SkillDescriptions) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "skill_descriptions")
+		fieldNames = append(fieldNames, "skill_descriptions")
 	}
 	if len(req.Interns) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "interns")
+		fieldNames = append(fieldNames, "interns")
 	}
 	if len(req.InternDescriptions) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "intern_descriptions")
+		fieldNames = append(fieldNames, "intern_descriptions")
 	}
 	if req.Organization != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "organization")
+		fieldNames = append(fieldNames, "organization")
 	}
 	if len(req.Certifications) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "certifications")
+		fieldNames = append(fieldNames, "certifications")
 	}
 	if len(req.CertificationDescriptions) > 0 {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "certification_descriptions")
+		fieldNames = append(fieldNames, "certification_descriptions")
 	}
 	if req.DesiredJobType != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "desired_job_type")
+		fieldNames = append(fieldNames, "desired_job_type")
 	}
 	if req.CompanySelectionCriteria != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "company_selection_criteria")
+		fieldNames = append(fieldNames, "company_selection_criteria")
 	}
 	if req.EngineerAspiration != "" {
-		u.lu.LogFieldUpdateWithErrorHandling(userID, targetTable, "engineer_aspiration")
+		fieldNames = append(fieldNames, "engineer_aspiration")
 	}
+
+	return fieldNames
 }
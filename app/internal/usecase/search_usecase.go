@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// SearchUsecase ESと全サービスのプロフィールを横断した検索のビジネスロジック
+type SearchUsecase interface {
+	// limitが0以下の場合は既定の件数、上限を超える場合は上限の件数を返す
+	Search(c *gin.Context, userID uuid.UUID, query string, limit int) (*entity.SearchResponse, error)
+}
+
+type searchUsecase struct {
+	sr repository.SearchRepository
+}
+
+func NewSearchUsecase(r repository.SearchRepository) SearchUsecase {
+	return &searchUsecase{sr: r}
+}
+
+func (u *searchUsecase) Search(c *gin.Context, userID uuid.UUID, query string, limit int) (*entity.SearchResponse, error) {
+	query, err := entity.NormalizeSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = entity.DefaultSearchLimit
+	}
+	if limit > entity.MaxSearchLimit {
+		limit = entity.MaxSearchLimit
+	}
+
+	terms := entity.SearchTerms(query)
+	hits, err := u.sr.Search(c, userID, query, terms, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 検索対象ごとの項目ラベル
+	labels := make(map[string]map[string]string)
+	displayNames := make(map[string]string)
+	for _, target := range entity.SearchTargets() {
+		labels[target.Table] = make(map[string]string)
+		for _, f := range entity.ModelFields(target.Model) {
+			labels[target.Table][f.Key] = f.Label
+		}
+		displayNames[target.Table] = target.DisplayName
+	}
+
+	results := make([]entity.SearchResult, 0, len(hits))
+	for _, hit := range hits {
+		label, ok := labels[hit.TargetTable][hit.FieldName]
+		if !ok {
+			// 項目として定義されていない列（一覧項目の不明なキーなど）は返さない
+			continue
+		}
+		snippet, highlights := entity.BuildSearchSnippet(hit.Content, terms)
+		results = append(results, entity.SearchResult{
+			TargetTable: hit.TargetTable,
+			ServiceName: displayNames[hit.TargetTable],
+			Field:       hit.FieldName,
+			FieldLabel:  label,
+			ItemID:      hit.ItemID,
+			Snippet:     snippet,
+			Highlights:  highlights,
+			Score:       hit.Score,
+		})
+	}
+
+	return &entity.SearchResponse{Query: query, Total: len(results), Results: results}, nil
+}
@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// ErrInvalidServiceData リクエストのdataがサービスのエンティティに変換できない
+var ErrInvalidServiceData = entity.NewKindError(entity.ErrValidation, "invalid service data")
+
+// ServiceUsecase 就活サービスごとのビジネスロジック
+type ServiceUsecase interface {
+	Definition() entity.ServiceDefinition
+	GetByUserID(c *gin.Context, userID uuid.UUID) (interface{}, error)
+	// expectedVersionはIf-Matchで指定されたバージョン（nilの場合は無条件）
+	CreateOrUpdate(c *gin.Context, userID uuid.UUID, data json.RawMessage, expectedVersion *int64) (interface{}, error)
+	// JSON Merge Patch（RFC 7396）で部分更新（キーがない項目はそのまま、nullの項目はクリア）
+	Patch(c *gin.Context, userID uuid.UUID, patch json.RawMessage, expectedVersion *int64) (interface{}, error)
+	// 一覧項目の操作（listKeyは一覧の主となる配列項目のキー、例: skills）
+	AddListItem(c *gin.Context, userID uuid.UUID, listKey string, values map[string]string, position *int, expectedVersion *int64) (interface{}, error)
+	UpdateListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, values map[string]string, expectedVersion *int64) (interface{}, error)
+	DeleteListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, expectedVersion *int64) (interface{}, error)
+	ReorderListItems(c *gin.Context, userID uuid.UUID, listKey string, itemIDs []uuid.UUID, expectedVersion *int64) (interface{}, error)
+}
+
+type serviceUsecase struct {
+	def entity.ServiceDefinition
+	sr  repository.ServiceRepository
+	uow repository.UnitOfWork
+}
+
+func NewServiceUsecase(def entity.ServiceDefinition, r repository.ServiceRepository, uow repository.UnitOfWork) ServiceUsecase {
+	return &serviceUsecase{def: def, sr: r, uow: uow}
+}
+
+func (u *serviceUsecase) Definition() entity.ServiceDefinition {
+	return u.def
+}
+
+func (u *serviceUsecase) GetByUserID(c *gin.Context, userID uuid.UUID) (interface{}, error) {
+	model, err := u.sr.GetByUserID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (u *serviceUsecase) CreateOrUpdate(c *gin.Context, userID uuid.UUID, data json.RawMessage, expectedVersion *int64) (interface{}, error) {
+	model, err := decodeServiceData(u.def, userID, data)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.ValidateModelSizes(model); err != nil {
+		return nil, err
+	}
+
+	// 変更履歴の比較・競合検出用に保存前の値を取得
+	before, err := u.sr.GetByUserID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckVersion(expectedVersion, before); err != nil {
+		return nil, err
+	}
+	// 一覧項目は配列項目から組み立て直す（既存の項目IDを引き継ぎ、リクエストのitemsは使わない）
+	entity.SetListItemsFrom(model, before)
+
+	// 空でないフィールドのログを記録
+	return u.save(c, userID, model, before, nonEmptyFieldKeys(u.def, model))
+}
+
+func (u *serviceUsecase) Patch(c *gin.Context, userID uuid.UUID, patch json.RawMessage, expectedVersion *int64) (interface{}, error) {
+	before, err := u.sr.GetByUserID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckVersion(expectedVersion, before); err != nil {
+		return nil, err
+	}
+
+	// レコードがなければ空のレコードにパッチを適用して作成
+	base := before
+	if base == nil {
+		base = u.def.NewModel()
+		entity.SetModelID(base, userID)
+	}
+	model, err := entity.ApplyMergePatch(base, patch)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.ValidateModelSizes(model); err != nil {
+		return nil, err
+	}
+	// 一覧項目は配列項目から組み立て直す（既存の項目IDを引き継ぐ）
+	entity.SetListItemsFrom(model, before)
+
+	changed := entity.ChangedFields(before, model)
+	if before != nil && len(changed) == 0 {
+		// 変更がない場合は保存せず、バージョンも進めない
+		return before, nil
+	}
+
+	// 実際に変更されたフィールドのみログを記録
+	return u.save(c, userID, model, before, changed)
+}
+
+func (u *serviceUsecase) AddListItem(c *gin.Context, userID uuid.UUID, listKey string, values map[string]string, position *int, expectedVersion *int64) (interface{}, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, true, func(model interface{}) error {
+		_, err := entity.AddListItem(model, listKey, values, position)
+		return err
+	})
+}
+
+func (u *serviceUsecase) UpdateListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, values map[string]string, expectedVersion *int64) (interface{}, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, false, func(model interface{}) error {
+		_, err := entity.UpdateListItem(model, listKey, itemID, values)
+		return err
+	})
+}
+
+func (u *serviceUsecase) DeleteListItem(c *gin.Context, userID uuid.UUID, listKey string, itemID uuid.UUID, expectedVersion *int64) (interface{}, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, false, func(model interface{}) error {
+		return entity.DeleteListItem(model, listKey, itemID)
+	})
+}
+
+func (u *serviceUsecase) ReorderListItems(c *gin.Context, userID uuid.UUID, listKey string, itemIDs []uuid.UUID, expectedVersion *int64) (interface{}, error) {
+	return u.editListItems(c, userID, listKey, expectedVersion, false, func(model interface{}) error {
+		return entity.ReorderListItems(model, listKey, itemIDs)
+	})
+}
+
+// 保存済みのレコードの一覧項目を操作して保存（createIfMissingがtrueの場合はレコードがなければ作成）
+func (u *serviceUsecase) editListItems(c *gin.Context, userID uuid.UUID, listKey string, expectedVersion *int64, createIfMissing bool, edit func(model interface{}) error) (interface{}, error) {
+	group, exists := entity.FindListGroup(u.def.NewModel(), listKey)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s has no list %s", entity.ErrListItemNotFound, u.def.Key, listKey)
+	}
+
+	before, err := u.sr.GetByUserID(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckVersion(expectedVersion, before); err != nil {
+		return nil, err
+	}
+
+	var model interface{}
+	switch {
+	case before != nil:
+		model = entity.CloneModel(before)
+	case createIfMissing:
+		model = u.def.NewModel()
+		entity.SetModelID(model, userID)
+	default:
+		return nil, fmt.Errorf("%w: %s record not found", entity.ErrListItemNotFound, u.def.ModelName())
+	}
+
+	if err := edit(model); err != nil {
+		return nil, err
+	}
+
+	// 一覧を構成する項目のログを記録
+	fieldNames := make([]string, 0, len(group.Fields))
+	for _, field := range group.Fields {
+		fieldNames = append(fieldNames, field.Key)
+	}
+	return u.save(c, userID, model, before, fieldNames)
+}
+
+// バージョンを確認して保存し、変更履歴とログを同じトランザクションで記録
+// ログや変更履歴の書き込みに失敗した場合は保存もロールバックする
+func (u *serviceUsecase) save(c *gin.Context, userID uuid.UUID, model, before interface{}, fieldNames []string) (interface{}, error) {
+	var result interface{}
+	err := u.uow.Do(c, func(tx repository.Tx) error {
+		saved, err := saveService(c, tx, u.def, userID, model, before, fieldNames)
+		result = saved
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrVersionConflict) {
+			// 読み込み後に他の書き込み（AI生成など）があった場合は現在の値を返す
+			current, _ := u.sr.GetByUserID(c, userID)
+			return nil, &entity.ConflictError{Err: err, Current: current}
+		}
+		return nil, err
+	}
+	recordAuditChange(c, userID, u.def.Key, entity.RevisionSourceManual, fieldNames)
+	return result, nil
+}
+
+// トランザクション内でバージョンを確認して保存し、変更履歴とログを記録（バッチ更新と共通）
+func saveService(ctx context.Context, tx repository.Tx, def entity.ServiceDefinition, userID uuid.UUID, model, before interface{}, fieldNames []string) (interface{}, error) {
+	saved, err := tx.Services(def).CreateOrUpdate(ctx, model, entity.ModelVersion(before))
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.RecordChanges(ctx, userID, def.Key, entity.RevisionSourceManual, before, model, fieldNames); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// リクエストのdataをサービスのエンティティに変換（dataにidが含まれていてもユーザーIDを優先する）
+func decodeServiceData(def entity.ServiceDefinition, userID uuid.UUID, data json.RawMessage) (interface{}, error) {
+	model := def.NewModel()
+	if err := json.Unmarshal(data, model); err != nil {
+		return nil, fmt.Errorf("%w for %s: %v", ErrInvalidServiceData, def.Key, err)
+	}
+	entity.SetModelID(model, userID)
+	return model, nil
+}
+
+// 値が空でないフィールドのキー
+func nonEmptyFieldKeys(def entity.ServiceDefinition, model interface{}) []string {
+	keys := make([]string, 0)
+	for _, field := range def.Fields() {
+		if !field.IsEmpty(model) {
+			keys = append(keys, field.Key)
+		}
+	}
+	return keys
+}
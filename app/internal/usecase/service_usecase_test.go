@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+	"job-hunting-service-management-backend/app/internal/repository/memory"
+)
+
+func TestServicePatch(t *testing.T) {
+	tests := []struct {
+		name              string
+		patch             string
+		wantCareerVision  string
+		wantSelfPromotion string
+		wantSkills        []string
+	}{
+		{
+			name:              "キーがない項目はそのまま",
+			patch:             `{"career_vision":"CTOになる"}`,
+			wantCareerVision:  "CTOになる",
+			wantSelfPromotion: "粘り強さ",
+			wantSkills:        []string{"Go"},
+		},
+		{
+			name:              "nullの項目はクリア",
+			patch:             `{"self_promotion":null}`,
+			wantCareerVision:  "エンジニア",
+			wantSelfPromotion: "",
+			wantSkills:        []string{"Go"},
+		},
+		{
+			name:              "nullの配列項目はクリア",
+			patch:             `{"skills":null,"skill_descriptions":null}`,
+			wantCareerVision:  "エンジニア",
+			wantSelfPromotion: "粘り強さ",
+			wantSkills:        nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := memory.NewRepositories()
+			userID := newTestUser(t, repos)
+			u := newServiceUsecase(t, repos, "supporterz")
+			c := newTestContext(t)
+
+			initial := `{"career_vision":"エンジニア","self_promotion":"粘り強さ","skills":["Go"],"skill_descriptions":["API開発"]}`
+			if _, err := u.CreateOrUpdate(c, userID, json.RawMessage(initial), nil); err != nil {
+				t.Fatalf("CreateOrUpdate() = %v", err)
+			}
+
+			got, err := u.Patch(c, userID, json.RawMessage(tt.patch), nil)
+			if err != nil {
+				t.Fatalf("Patch() = %v", err)
+			}
+			model := got.(*entity.Supporterz)
+			if model.CareerVision != tt.wantCareerVision {
+				t.Errorf("career_vision = %q, want %q", model.CareerVision, tt.wantCareerVision)
+			}
+			if model.SelfPromotion != tt.wantSelfPromotion {
+				t.Errorf("self_promotion = %q, want %q", model.SelfPromotion, tt.wantSelfPromotion)
+			}
+			if len(model.Skills) != len(tt.wantSkills) || (len(tt.wantSkills) > 0 && !reflect.DeepEqual([]string(model.Skills), tt.wantSkills)) {
+				t.Errorf("skills = %v, want %v", model.Skills, tt.wantSkills)
+			}
+		})
+	}
+}
+
+func TestServiceListItems(t *testing.T) {
+	repos := memory.NewRepositories()
+	userID := newTestUser(t, repos)
+	u := newServiceUsecase(t, repos, "supporterz")
+	c := newTestContext(t)
+
+	var ids []uuid.UUID
+	for _, skill := range []string{"Go", "TypeScript", "SQL"} {
+		got, err := u.AddListItem(c, userID, "skills", map[string]string{"skills": skill, "skill_descriptions": skill + "の説明"}, nil, nil)
+		if err != nil {
+			t.Fatalf("AddListItem(%s) = %v", skill, err)
+		}
+		items := got.(*entity.Supporterz).Items["skills"]
+		ids = append(ids, items[len(items)-1].ID)
+	}
+
+	tests := []struct {
+		name       string
+		edit       func() (interface{}, error)
+		wantIDs    []uuid.UUID
+		wantSkills []string
+	}{
+		{
+			name: "並べ替えても項目IDは変わらない",
+			edit: func() (interface{}, error) {
+				return u.ReorderListItems(c, userID, "skills", []uuid.UUID{ids[2], ids[0], ids[1]}, nil)
+			},
+			wantIDs:    []uuid.UUID{ids[2], ids[0], ids[1]},
+			wantSkills: []string{"SQL", "Go", "TypeScript"},
+		},
+		{
+			name: "削除しても他の項目IDは変わらない",
+			edit: func() (interface{}, error) {
+				return u.DeleteListItem(c, userID, "skills", ids[0], nil)
+			},
+			wantIDs:    []uuid.UUID{ids[2], ids[1]},
+			wantSkills: []string{"SQL", "TypeScript"},
+		},
+		{
+			name: "更新しても項目IDは変わらない",
+			edit: func() (interface{}, error) {
+				return u.UpdateListItem(c, userID, "skills", ids[1], map[string]string{"skills": "TS"}, nil)
+			},
+			wantIDs:    []uuid.UUID{ids[2], ids[1]},
+			wantSkills: []string{"SQL", "TS"},
+		},
+	}
+	// 前のケースの結果を前提に順に操作する
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.edit(); err != nil {
+				t.Fatalf("edit = %v", err)
+			}
+			got, err := u.GetByUserID(c, userID)
+			if err != nil {
+				t.Fatalf("GetByUserID() = %v", err)
+			}
+			model := got.(*entity.Supporterz)
+			var gotIDs []uuid.UUID
+			for _, item := range model.Items["skills"] {
+				gotIDs = append(gotIDs, item.ID)
+			}
+			if !reflect.DeepEqual(gotIDs, tt.wantIDs) {
+				t.Errorf("item IDs = %v, want %v", gotIDs, tt.wantIDs)
+			}
+			if !reflect.DeepEqual([]string(model.Skills), tt.wantSkills) {
+				t.Errorf("skills = %v, want %v", model.Skills, tt.wantSkills)
+			}
+		})
+	}
+}
+
+func TestServiceVersionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		save    func(repos *repository.Repositories, u ServiceUsecase, userID uuid.UUID) error
+		wantErr error
+	}{
+		{
+			name: "If-Matchが一致する場合は保存する",
+			save: func(repos *repository.Repositories, u ServiceUsecase, userID uuid.UUID) error {
+				_, err := u.CreateOrUpdate(newTestContext(t), userID, json.RawMessage(`{"career_vision":"更新"}`), int64Ptr(1))
+				return err
+			},
+		},
+		{
+			name: "If-Matchが一致しない場合は412",
+			save: func(repos *repository.Repositories, u ServiceUsecase, userID uuid.UUID) error {
+				_, err := u.CreateOrUpdate(newTestContext(t), userID, json.RawMessage(`{"career_vision":"更新"}`), int64Ptr(5))
+				return err
+			},
+			wantErr: entity.ErrPreconditionFailed,
+		},
+		{
+			name: "読み込み後に他の書き込みがあった場合は409",
+			save: func(repos *repository.Repositories, u ServiceUsecase, userID uuid.UUID) error {
+				c := newTestContext(t)
+				def := u.Definition()
+				// 読み込んだ時点のバージョン1で保存する前に、別の書き込みでバージョンが進む
+				if _, err := u.Patch(c, userID, json.RawMessage(`{"self_promotion":"別の書き込み"}`), nil); err != nil {
+					return err
+				}
+				return repos.UnitOfWork.Do(c, func(tx repository.Tx) error {
+					model := def.NewModel().(*entity.Supporterz)
+					model.ID = userID
+					_, err := tx.Services(def).CreateOrUpdate(c, model, 1)
+					return err
+				})
+			},
+			wantErr: entity.ErrVersionConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := memory.NewRepositories()
+			userID := newTestUser(t, repos)
+			u := newServiceUsecase(t, repos, "supporterz")
+			if _, err := u.CreateOrUpdate(newTestContext(t), userID, json.RawMessage(`{"career_vision":"初期"}`), nil); err != nil {
+				t.Fatalf("CreateOrUpdate() = %v", err)
+			}
+
+			err := tt.save(repos, u, userID)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("save = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("save = %v, want %v", err, tt.wantErr)
+			}
+			// 412と409はどちらも競合の種類に属し、エラーのマッピングでErrPreconditionFailedを412に振り分ける
+			if !errors.Is(err, entity.ErrConflict) {
+				t.Errorf("save = %v, want kind ErrConflict", err)
+			}
+			if tt.wantErr == entity.ErrVersionConflict && errors.Is(err, entity.ErrPreconditionFailed) {
+				t.Errorf("save = %v, must not be ErrPreconditionFailed", err)
+			}
+		})
+	}
+}
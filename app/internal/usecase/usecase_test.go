@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository"
+)
+
+// newTestContext ハンドラーを通さずにユースケースを呼ぶためのgin.Context（認証されたユーザーなし）
+func newTestContext(t *testing.T) *gin.Context {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/", nil)
+	return c
+}
+
+// newTestUser テスト用のユーザーを作成
+func newTestUser(t *testing.T, repos *repository.Repositories) uuid.UUID {
+	t.Helper()
+	user := &entity.User{
+		UserID:        uuid.New(),
+		LastName:      "山田",
+		FirstName:     "太郎",
+		Age:           21,
+		University:    "東京大学",
+		Category:      "理系",
+		Faculty:       "工学部",
+		Grade:         3,
+		TargetJobType: "エンジニア",
+	}
+	if err := repos.Users.CreateUser(newTestContext(t), user); err != nil {
+		t.Fatalf("CreateUser() = %v", err)
+	}
+	return user.UserID
+}
+
+// newServiceUsecase レジストリのサービスのユースケース
+func newServiceUsecase(t *testing.T, repos *repository.Repositories, key string) ServiceUsecase {
+	t.Helper()
+	def, exists := entity.ResolveService(key)
+	if !exists {
+		t.Fatalf("service %s is not registered", key)
+	}
+	return NewServiceUsecase(def, repos.Services[def.Key], repos.UnitOfWork)
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
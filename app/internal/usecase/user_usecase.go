@@ -1,55 +1,61 @@
 package usecase
 
 import (
+	"errors"
 	"fmt"
+	"log"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 
 	"job-hunting-service-management-backend/app/internal/entity"
 	"job-hunting-service-management-backend/app/internal/repository"
 )
 
+// ErrUserNotFound 指定されたユーザーが存在しない
+var ErrUserNotFound = entity.NewKindError(entity.ErrNotFound, "user not found")
+
 type UserUsecase interface {
-	UpdateUserServices(c *gin.Context, userID string, services []string) error
-	CreateUser(c *gin.Context, userID uuid.UUID, req entity.CreateUserData) (*entity.User, error)
-	UpdateUser(c *gin.Context, userID uuid.UUID, req entity.UserData) (*entity.User, error)
+	// expectedVersionはIf-Matchで指定されたバージョン（nilの場合は無条件）
+	UpdateUserServices(c *gin.Context, userID string, services []string, expectedVersion *int64) (*entity.User, error)
+	CreateUser(c *gin.Context, userID uuid.UUID, req entity.CreateUserData, expectedVersion *int64) (*entity.User, error)
+	UpdateUser(c *gin.Context, userID uuid.UUID, req entity.UserData, expectedVersion *int64) (*entity.User, error)
+	// JSON Merge Patch（RFC 7396）で部分更新（キーがない項目はそのまま、nullの項目はクリア）
+	PatchUser(c *gin.Context, userID uuid.UUID, patch []byte, expectedVersion *int64) (*entity.User, error)
 	GetUserByID(c *gin.Context, userID string) (*entity.User, error)
 	GetUserServices(c *gin.Context, userID string) ([]string, error)
 	GetUserServiceDetails(c *gin.Context, userID string) (map[string]interface{}, error)
+	DeleteUser(c *gin.Context, userID uuid.UUID) (*entity.UserDeletionReceipt, error)
 }
 
 type userUsecase struct {
-	ur             repository.UserRepository
-	aiUsecase      AIGenerationUsecase
-	profileUsecase ProfileUsecase
+	ur                   repository.UserRepository
+	aiUsecase            AIGenerationUsecase
+	profileUsecase       ProfileUsecase
+	customServiceUsecase CustomServiceUsecase
 }
 
-func NewUserUsecase(r repository.UserRepository, aiUsecase AIGenerationUsecase, profileUsecase ProfileUsecase) UserUsecase {
+func NewUserUsecase(r repository.UserRepository, aiUsecase AIGenerationUsecase, profileUsecase ProfileUsecase, customServiceUsecase CustomServiceUsecase) UserUsecase {
 	return &userUsecase{
-		ur:             r,
-		aiUsecase:      aiUsecase,
-		profileUsecase: profileUsecase,
+		ur:                   r,
+		aiUsecase:            aiUsecase,
+		profileUsecase:       profileUsecase,
+		customServiceUsecase: customServiceUsecase,
 	}
 }
 
-func (u *userUsecase) UpdateUserServices(c *gin.Context, userID string, services []string) error {
+func (u *userUsecase) UpdateUserServices(c *gin.Context, userID string, services []string, expectedVersion *int64) (*entity.User, error) {
 	// サービス情報を更新
-	if err := u.ur.UpdateUserServices(c, userID, services); err != nil {
-		return err
+	user, err := u.ur.UpdateUserServices(c, userID, services, expectedVersion)
+	if err != nil {
+		return nil, userNotFound(err, userID)
 	}
+	recordAuditChange(c, user.UserID, user.TableName(), entity.RevisionSourceManual, []string{"services"})
 
 	// サービスが設定されている場合、AI生成を実行
 	if len(services) > 0 {
-		// UUIDに変換
-		userUUID, err := uuid.Parse(userID)
-		if err != nil {
-			// UUID変換エラーの場合はログに記録するが、処理は継続
-			// TODO: ログ出力を追加
-			return nil
-		}
-
 		// 日本語サービス名を英語名に変換
 		convertedServices := make([]string, 0, len(services))
 		for _, service := range services {
@@ -62,17 +68,16 @@ func (u *userUsecase) UpdateUserServices(c *gin.Context, userID string, services
 		// 変換されたサービスがある場合のみAI生成を実行
 		if len(convertedServices) > 0 {
 			// AI生成を実行（エラーが発生してもサービス更新は成功とする）
-			if _, err := u.aiUsecase.GenerateServiceProfiles(c, userUUID, convertedServices); err != nil {
-				// AI生成エラーはログに記録するが、処理は継続
-				fmt.Printf("AI generation failed for user %s: %v\n", userUUID, err)
+			if _, err := u.aiUsecase.GenerateServiceProfiles(c, user.UserID, convertedServices); err != nil {
+				log.Printf("AI generation failed for user %s: %v", user.UserID, err)
 			}
 		}
 	}
 
-	return nil
+	return user, nil
 }
 
-func (u *userUsecase) CreateUser(c *gin.Context, userID uuid.UUID, req entity.CreateUserData) (*entity.User, error) {
+func (u *userUsecase) CreateUser(c *gin.Context, userID uuid.UUID, req entity.CreateUserData, expectedVersion *int64) (*entity.User, error) {
 	// 全ての項目が入ってくる想定なので、直接マップに設定
 	updateData := map[string]interface{}{
 		"last_name":       req.LastName,
@@ -91,10 +96,16 @@ func (u *userUsecase) CreateUser(c *gin.Context, userID uuid.UUID, req entity.Cr
 	}
 
 	// リポジトリに更新用データマップを渡す
-	return u.ur.UpdateUser(c, userID.String(), updateData)
+	return u.updateUser(c, userID, updateData, expectedVersion)
+}
+
+func (u *userUsecase) UpdateUser(c *gin.Context, userID uuid.UUID, req entity.UserData, expectedVersion *int64) (*entity.User, error) {
+	// リポジトリに更新用データマップを渡す
+	return u.updateUser(c, userID, userUpdateData(req), expectedVersion)
 }
 
-func (u *userUsecase) UpdateUser(c *gin.Context, userID uuid.UUID, req entity.UserData) (*entity.User, error) {
+// userUpdateData 空でない項目のみの更新用データマップ（バッチ更新と共通）
+func userUpdateData(req entity.UserData) map[string]interface{} {
 	// 更新するフィールドをマップに格納
 	updateData := make(map[string]interface{})
 
@@ -130,14 +141,72 @@ func (u *userUsecase) UpdateUser(c *gin.Context, userID uuid.UUID, req entity.Us
 		updateData["grade"] = *req.Grade
 	}
 
-	// リポジトリに更新用データマップを渡す
-	return u.ur.UpdateUser(c, userID.String(), updateData)
+	return updateData
+}
+
+// 更新用データマップで保存し、更新した項目を監査イベントに記録
+func (u *userUsecase) updateUser(c *gin.Context, userID uuid.UUID, updateData map[string]interface{}, expectedVersion *int64) (*entity.User, error) {
+	user, err := u.ur.UpdateUser(c, userID.String(), updateData, expectedVersion)
+	if err != nil {
+		return nil, userNotFound(err, userID)
+	}
+	recordAuditChange(c, userID, user.TableName(), entity.RevisionSourceManual, sortedKeys(updateData))
+	return user, nil
+}
+
+func (u *userUsecase) PatchUser(c *gin.Context, userID uuid.UUID, patch []byte, expectedVersion *int64) (*entity.User, error) {
+	existingUser, err := u.ur.GetUserByID(c, userID.String())
+	if err != nil {
+		return nil, userNotFound(err, userID)
+	}
+	if err := entity.CheckVersion(expectedVersion, existingUser); err != nil {
+		return nil, err
+	}
+
+	patched, err := entity.ApplyMergePatch(existingUser, patch)
+	if err != nil {
+		return nil, err
+	}
+	user := patched.(*entity.User)
+	if err := validatePatchedUser(user); err != nil {
+		return nil, err
+	}
+
+	changed := entity.ChangedFields(existingUser, user)
+	if len(changed) == 0 {
+		// 変更がない場合は保存せず、バージョンも進めない
+		return existingUser, nil
+	}
+
+	if err := u.ur.SaveUser(c, user, existingUser.Version); err != nil {
+		if errors.Is(err, entity.ErrVersionConflict) {
+			current, _ := u.ur.GetUserByID(c, userID.String())
+			return nil, &entity.ConflictError{Err: err, Current: current}
+		}
+		return nil, err
+	}
+	recordAuditChange(c, userID, user.TableName(), entity.RevisionSourceManual, changed)
+	return user, nil
+}
+
+// パッチ適用後のユーザー情報を検証（必須項目はnullでクリアできない）
+func validatePatchedUser(user *entity.User) error {
+	if user.LastName == "" || user.FirstName == "" || user.TargetJobType == "" {
+		return fmt.Errorf("%w: last_name, first_name and target_job_type cannot be cleared", entity.ErrInvalidPatch)
+	}
+	if user.Age < 0 || user.Age > 150 {
+		return fmt.Errorf("%w: age must be between 0 and 150", entity.ErrInvalidPatch)
+	}
+	if user.Grade < 1 || user.Grade > 10 {
+		return fmt.Errorf("%w: grade must be between 1 and 10", entity.ErrInvalidPatch)
+	}
+	return entity.ValidateModelSizes(user)
 }
 
 func (u *userUsecase) GetUserByID(c *gin.Context, userID string) (*entity.User, error) {
 	user, err := u.ur.GetUserByID(c, userID)
 	if err != nil {
-		return nil, err
+		return nil, userNotFound(err, userID)
 	}
 
 	// ここでビジネスロジックを追加（例：データ変換、追加のチェックなど）
@@ -148,7 +217,7 @@ func (u *userUsecase) GetUserByID(c *gin.Context, userID string) (*entity.User,
 func (u *userUsecase) GetUserServices(c *gin.Context, userID string) ([]string, error) {
 	user, err := u.ur.GetUserByID(c, userID)
 	if err != nil {
-		return nil, err
+		return nil, userNotFound(err, userID)
 	}
 
 	// user.Servicesがpq.StringArrayの場合、[]stringに変換
@@ -165,27 +234,18 @@ func (u *userUsecase) GetUserServiceDetails(c *gin.Context, userID string) (map[
 		return nil, err
 	}
 
-	// サービスリストとAPIエンドポイントのマッピングを返す
+	// サービスリストとAPIエンドポイント（/api/v1のルート）のマッピングを返す
 	serviceEndpoints := make(map[string]string)
 	for _, serviceName := range services {
-		switch serviceName {
-		case "サポーターズ":
-			serviceEndpoints["supporterz"] = "/api/supporterz/" + userID
-		case "マイナビ":
-			serviceEndpoints["mynavi"] = "/api/mynavi/" + userID
-		case "レバテックルーキー":
-			serviceEndpoints["levtech_rookie"] = "/api/levtech-rookie/" + userID
-		case "ワンキャリア":
-			serviceEndpoints["one_career"] = "/api/one-career/" + userID
-		case "キャリアセレクト":
-			serviceEndpoints["career_select"] = "/api/career-select/" + userID
+		if def, exists := entity.FindServiceByDisplayName(serviceName); exists {
+			serviceEndpoints[def.Key] = "/api/v1/users/" + userID + "/services/" + def.RoutePath
 		}
 	}
 
 	// プロフィール情報を取得
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user ID format: %v", err)
+		return nil, entity.NewValidationError("user_id", "must be a UUID")
 	}
 
 	profile, err := u.profileUsecase.GetProfileByUserID(c, userUUID)
@@ -197,12 +257,50 @@ func (u *userUsecase) GetUserServiceDetails(c *gin.Context, userID string) (map[
 		profileData = profile
 	}
 
+	// ユーザー定義のカスタムサービスのエンドポイントを追加
+	customServices, err := u.customServiceUsecase.GetCustomServicesByUserID(c, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	customServiceEndpoints := make([]map[string]string, 0, len(customServices))
+	for _, customService := range customServices {
+		customServiceEndpoints = append(customServiceEndpoints, map[string]string{
+			"id":       customService.ID.String(),
+			"name":     customService.Name,
+			"endpoint": "/api/v1/custom-services/" + customService.ID.String(),
+		})
+	}
+
 	result := map[string]interface{}{
 		"user_id":           userID,
 		"services":          services,
 		"service_endpoints": serviceEndpoints,
+		"custom_services":   customServiceEndpoints,
 		"profile":           profileData,
 	}
 
 	return result, nil
 }
+
+// DeleteUser ユーザーとES・各サービス・ログ等の関連データを全て削除し、削除証明を返します。
+// ユーザーが存在しない場合はErrUserNotFoundを返します。
+// 削除後に記録する監査イベントは、削除の記録として残る
+func (u *userUsecase) DeleteUser(c *gin.Context, userID uuid.UUID) (*entity.UserDeletionReceipt, error) {
+	receipt, err := u.ur.DeleteUser(c, userID)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUserNotFound, userID)
+	}
+	recordAuditChange(c, userID, entity.User{}.TableName(), entity.AuditSourceDelete, nil)
+	return receipt, nil
+}
+
+// userNotFound リポジトリのレコードが見つからないエラーをErrUserNotFoundに変換
+func userNotFound(err error, userID interface{}) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %v", ErrUserNotFound, userID)
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"job-hunting-service-management-backend/app/internal/entity"
+	"job-hunting-service-management-backend/app/internal/repository/memory"
+)
+
+func TestPatchUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		patch          string
+		wantErr        error
+		wantUniversity string
+		wantFaculty    string
+		wantVersion    int64
+	}{
+		{
+			name:           "キーがない項目はそのまま",
+			patch:          `{"university":"京都大学"}`,
+			wantUniversity: "京都大学",
+			wantFaculty:    "工学部",
+			wantVersion:    2,
+		},
+		{
+			name:           "nullの項目はクリア",
+			patch:          `{"faculty":null}`,
+			wantUniversity: "東京大学",
+			wantFaculty:    "",
+			wantVersion:    2,
+		},
+		{
+			name:           "変更がない場合はバージョンを進めない",
+			patch:          `{"university":"東京大学"}`,
+			wantUniversity: "東京大学",
+			wantFaculty:    "工学部",
+			wantVersion:    1,
+		},
+		{
+			name:    "必須項目はnullでクリアできない",
+			patch:   `{"last_name":null}`,
+			wantErr: entity.ErrInvalidPatch,
+		},
+		{
+			name:    "範囲外の学年は保存しない",
+			patch:   `{"grade":11}`,
+			wantErr: entity.ErrInvalidPatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repos := memory.NewRepositories()
+			userID := newTestUser(t, repos)
+			u := NewUserUsecase(repos.Users, nil, nil, nil)
+			c := newTestContext(t)
+
+			got, err := u.PatchUser(c, userID, []byte(tt.patch), nil)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("PatchUser() = %v, want %v", err, tt.wantErr)
+				}
+				saved, _ := u.GetUserByID(c, userID.String())
+				if saved.Version != 1 {
+					t.Errorf("version = %d, want 1 (not saved)", saved.Version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PatchUser() = %v", err)
+			}
+			if got.University != tt.wantUniversity || got.Faculty != tt.wantFaculty {
+				t.Errorf("university, faculty = %q, %q, want %q, %q", got.University, got.Faculty, tt.wantUniversity, tt.wantFaculty)
+			}
+			saved, err := u.GetUserByID(c, userID.String())
+			if err != nil {
+				t.Fatalf("GetUserByID() = %v", err)
+			}
+			if saved.Faculty != tt.wantFaculty || saved.Version != tt.wantVersion {
+				t.Errorf("saved faculty, version = %q, %d, want %q, %d", saved.Faculty, saved.Version, tt.wantFaculty, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestGetUserServiceDetailsEndpoints(t *testing.T) {
+	repos := memory.NewRepositories()
+	userID := newTestUser(t, repos)
+	c := newTestContext(t)
+	def := entity.Services[0]
+	if _, err := repos.Users.UpdateUserServices(c, userID.String(), []string{def.DisplayName}, nil); err != nil {
+		t.Fatalf("UpdateUserServices() = %v", err)
+	}
+	customServiceUsecase := NewCustomServiceUsecase(repos.CustomServices, repos.UnitOfWork)
+	customService, err := customServiceUsecase.CreateCustomService(c, userID, entity.CustomServiceData{
+		Name:   "独自サービス",
+		Fields: []entity.CustomServiceField{{Key: "motivation", Label: "志望動機", Type: entity.FieldTypeText}},
+	})
+	if err != nil {
+		t.Fatalf("CreateCustomService() = %v", err)
+	}
+	u := NewUserUsecase(repos.Users, nil, NewProfileUsecase(repos.Profiles, repos.UnitOfWork), customServiceUsecase)
+
+	details, err := u.GetUserServiceDetails(c, userID.String())
+	if err != nil {
+		t.Fatalf("GetUserServiceDetails() = %v", err)
+	}
+	// 非推奨の旧ルートではなく/api/v1の後継のルートを返す
+	endpoints := details["service_endpoints"].(map[string]string)
+	if want := "/api/v1/users/" + userID.String() + "/services/" + def.RoutePath; endpoints[def.Key] != want {
+		t.Errorf("service_endpoints[%s] = %q, want %q", def.Key, endpoints[def.Key], want)
+	}
+	customServices := details["custom_services"].([]map[string]string)
+	if want := "/api/v1/custom-services/" + customService.ID.String(); len(customServices) != 1 || customServices[0]["endpoint"] != want {
+		t.Errorf("custom_services = %v, want endpoint %q", customServices, want)
+	}
+}